@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// configFileName is the name of the optional config file holding default flag values
+const configFileName = ".dqc.yaml"
+
+// findConfigFile returns the path of the first config file found in the current directory or the home directory.
+// It returns an empty string when no config file exists.
+func findConfigFile() string {
+	var dirs []string
+	if cwd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, cwd)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, home)
+	}
+
+	for _, dir := range dirs {
+		path := filepath.Join(dir, configFileName)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// loadConfig reads a YAML config file mapping persistent flag names (e.g. db-path) to default values
+func loadConfig(path string) (map[string]interface{}, error) {
+	config := map[string]interface{}{}
+	if err := loadYAMLFile(path, &config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// loadYAMLFile decodes a YAML file (e.g. a check's mapping or ranges) into out
+func loadYAMLFile(path string, out interface{}) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(content, out); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return nil
+}
+
+// applyConfigDefaults sets each configured flag that was not given explicitly on the command line.
+// Explicit CLI flags always win over config values. Lists are joined with commas.
+func applyConfigDefaults(flags *pflag.FlagSet, config map[string]interface{}) error {
+	for key, value := range config {
+		flag := flags.Lookup(key)
+		if flag == nil {
+			return fmt.Errorf("unknown config key: %s", key)
+		}
+		if flag.Changed {
+			continue
+		}
+
+		var strValue string
+		switch v := value.(type) {
+		case []interface{}:
+			parts := make([]string, len(v))
+			for i, item := range v {
+				parts[i] = fmt.Sprintf("%v", item)
+			}
+			strValue = strings.Join(parts, ",")
+		default:
+			strValue = fmt.Sprintf("%v", v)
+		}
+
+		if err := flag.Value.Set(strValue); err != nil {
+			return fmt.Errorf("invalid config value for %s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func writeConfig(t *testing.T, dir, content string) string {
+	path := filepath.Join(dir, configFileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func newTestFlags() (*pflag.FlagSet, *string) {
+	flags := pflag.NewFlagSet("dqc", pflag.ContinueOnError)
+	path := flags.String("db-path", "quality_checks.db", "Path to the SQLite database for logging")
+	return flags, path
+}
+
+func TestFindConfigFile(t *testing.T) {
+	cwd := t.TempDir()
+	home := t.TempDir()
+	t.Chdir(cwd)
+	t.Setenv("HOME", home)
+
+	if path := findConfigFile(); path != "" {
+		t.Errorf("Expected no config file, got %s", path)
+	}
+
+	homeConfig := writeConfig(t, home, "db-path: home.db\n")
+	if path := findConfigFile(); path != homeConfig {
+		t.Errorf("Expected home config %s, got %s", homeConfig, path)
+	}
+
+	// The current directory takes precedence over the home directory
+	cwdConfig := writeConfig(t, cwd, "db-path: cwd.db\n")
+	if path := findConfigFile(); path != cwdConfig {
+		t.Errorf("Expected cwd config %s, got %s", cwdConfig, path)
+	}
+}
+
+func TestApplyConfigDefaults(t *testing.T) {
+	configPath := writeConfig(t, t.TempDir(), "db-path: from_config.db\n")
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// No flag given: config value is used
+	flags, dbPath := newTestFlags()
+	if err := flags.Parse([]string{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyConfigDefaults(flags, config); err != nil {
+		t.Fatal(err)
+	}
+	if *dbPath != "from_config.db" {
+		t.Errorf("Expected db-path from config, got %s", *dbPath)
+	}
+
+	// Explicit flag overrides config value
+	flags, dbPath = newTestFlags()
+	if err := flags.Parse([]string{"--db-path", "from_flag.db"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyConfigDefaults(flags, config); err != nil {
+		t.Fatal(err)
+	}
+	if *dbPath != "from_flag.db" {
+		t.Errorf("Expected db-path from flag, got %s", *dbPath)
+	}
+
+	// Unknown keys are reported
+	flags, _ = newTestFlags()
+	if err := applyConfigDefaults(flags, map[string]interface{}{"db_path": "typo.db"}); err == nil {
+		t.Error("Expected error for unknown config key")
+	}
+}
@@ -1,27 +1,60 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/josephmachado/data_quality_checker/internal/checker"
 	"github.com/josephmachado/data_quality_checker/internal/db"
+	"github.com/josephmachado/data_quality_checker/internal/suite"
 	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
 var (
-	dbPath  string
-	version = "v1.1.0"
+	dbPath      string
+	scanOptions checker.ScanOptions
+	severity    string
+	version     = "v1.1.0"
 )
 
+// Process exit codes, so that dqc can gate CI pipelines
+const (
+	exitCheckFailed = 1 // a check ran and did not pass
+	exitError       = 2 // a check could not run (bad flags, missing file, query error, ...)
+)
+
+// errCheckFailed is returned by a command whose check ran but did not pass.
+// The command prints its own failure message, so main only maps it to an exit code.
+var errCheckFailed = errors.New("data quality check failed")
+
 // main is the entry point for the Data Quality Checker CLI application
 func main() {
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		os.Exit(exitCode(err))
+	}
+}
+
+// exitCode prints a genuine error (as JSON in --output json mode) and maps it to the process exit code
+func exitCode(err error) int {
+	if errors.Is(err, errCheckFailed) {
+		return exitCheckFailed
+	}
+	var reported reportedError
+	if errors.As(err, &reported) {
+		return exitError
+	}
+	if outputFormat == outputJSON {
+		printJSON(map[string]string{"error": err.Error()})
+	} else {
+		pterm.Error.Printf("Error: %v\n", err)
 	}
+	return exitError
 }
 
 var rootCmd = &cobra.Command{
@@ -29,12 +62,43 @@ var rootCmd = &cobra.Command{
 	Short:   "Data Quality Checker CLI",
 	Long:    `A CLI tool for validating data quality on CSV/Parquet files using DuckDB.`,
 	Version: version,
+	// Errors are printed by main so that failures and genuine errors get distinct exit codes
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	// Fill persistent flags that were not given explicitly from .dqc.yaml, if present
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if configPath := findConfigFile(); configPath != "" {
+			config, err := loadConfig(configPath)
+			if err != nil {
+				return err
+			}
+			if err := applyConfigDefaults(cmd.Root().PersistentFlags(), config); err != nil {
+				return err
+			}
+		}
+		if err := checker.ValidateFormat(scanOptions.Format); err != nil {
+			return fmt.Errorf("invalid --data-format: %w", err)
+		}
+		if _, err := checker.ParseSeverity(severity); err != nil {
+			return fmt.Errorf("invalid --severity: %w", err)
+		}
+		return applyOutputFormat()
+	},
 }
 
 func init() {
 	// Persistent flag for DB path, as it's common to all commands (conceptually)
 	// In Python it was repeated for each command.
 	rootCmd.PersistentFlags().StringVar(&dbPath, "db-path", "quality_checks.db", "Path to the SQLite database for logging")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", outputText, "Output format: text or json")
+	rootCmd.PersistentFlags().StringVar(&severity, "severity", string(checker.SeverityError), "Severity of a failure: error fails with exit code 1, warn and info are reported and exit 0")
+	rootCmd.PersistentFlags().StringVar(&scanOptions.Format, "data-format", "", "Read data files as csv, json, or parquet (detected from the extension by default)")
+	// check-list-elements already has a --delimiter flag for list elements, so the CSV field separator is --csv-delimiter
+	rootCmd.PersistentFlags().StringVar(&scanOptions.Delimiter, "csv-delimiter", "", "CSV field delimiter, e.g. ';' (sniffed by default)")
+	rootCmd.PersistentFlags().BoolVar(&scanOptions.NoHeader, "no-header", false, "CSV files have no header row")
+	rootCmd.PersistentFlags().StringVar(&scanOptions.NullString, "null-string", "", "CSV token to read as NULL, e.g. NA")
+	rootCmd.PersistentFlags().BoolVar(&scanOptions.HivePartitioning, "hive-partitioning", false, "Expose Hive partition keys (year=2023/) of Parquet paths as columns")
+	rootCmd.PersistentFlags().StringVar(&scanOptions.Filter, "filter", "", "SQL predicate limiting checks to matching rows of the data file, e.g. \"region = 'EU'\"")
 
 	rootCmd.AddCommand(checkUniqueCmd)
 	rootCmd.AddCommand(checkNotNullCmd)
@@ -49,89 +113,161 @@ func init() {
 	rootCmd.AddCommand(checkMinCmd)
 	rootCmd.AddCommand(checkMeanCmd)
 	rootCmd.AddCommand(checkMedianCmd)
+	rootCmd.AddCommand(checkSumCmd)
+	rootCmd.AddCommand(checkStdDevCmd)
+	rootCmd.AddCommand(checkQuantileCmd)
 	rootCmd.AddCommand(checkDateFormatCmd)
 	rootCmd.AddCommand(checkRowCountCmd)
 	rootCmd.AddCommand(checkColCountCmd)
 	rootCmd.AddCommand(checkNotInSetCmd)
 	rootCmd.AddCommand(checkIncreasingCmd)
+	rootCmd.AddCommand(checkDecreasingCmd)
 	rootCmd.AddCommand(checkDateParseableCmd)
 	rootCmd.AddCommand(checkPairEqualCmd)
 	rootCmd.AddCommand(checkDistinctInSetCmd)
+	rootCmd.AddCommand(checkGroupSumCmd)
+	rootCmd.AddCommand(checkChecksumCmd)
+	rootCmd.AddCommand(checkDistinctStableCmd)
+	rootCmd.AddCommand(checkListElementsCmd)
+	rootCmd.AddCommand(checkGitSHACmd)
+	rootCmd.AddCommand(checkVersionRangesCmd)
+	rootCmd.AddCommand(checkJSONSafeCmd)
+	rootCmd.AddCommand(checkNoDupColumnsCmd)
+	rootCmd.AddCommand(checkSigFigsCmd)
+	rootCmd.AddCommand(checkPathsExistCmd)
+	rootCmd.AddCommand(checkConditionalEnumCmd)
+	rootCmd.AddCommand(runCmd)
+	rootCmd.AddCommand(runRulesCmd)
+	rootCmd.AddCommand(scoreCmd)
+	rootCmd.AddCommand(checkNumericTypedCmd)
+	rootCmd.AddCommand(checkJSONPointerCmd)
+	rootCmd.AddCommand(checkChurnCmd)
+	rootCmd.AddCommand(checkWordCountCmd)
+	rootCmd.AddCommand(checkUniqueCompositeCmd)
+	rootCmd.AddCommand(checkAllocationRangeCmd)
+	rootCmd.AddCommand(checkDistinctCountCmd)
+	rootCmd.AddCommand(checkGranularityCmd)
+	rootCmd.AddCommand(checkValueRateCmd)
+	rootCmd.AddCommand(checkNullFractionCmd)
+	rootCmd.AddCommand(checkReferenceAttributeCmd)
+	rootCmd.AddCommand(checkSumsToCmd)
+	rootCmd.AddCommand(checkNoLongRunsCmd)
+	rootCmd.AddCommand(checkIdenticalCmd)
+	rootCmd.AddCommand(checkNoNearZeroCmd)
+	rootCmd.AddCommand(checkEpochCmd)
+	rootCmd.AddCommand(checkNoDuplicateRowsCmd)
+	rootCmd.AddCommand(checkMonotonicAfterCmd)
+	rootCmd.AddCommand(checkCompareCmd)
+	rootCmd.AddCommand(checkQuotingCmd)
+	rootCmd.AddCommand(checkConditionalCmd)
+	rootCmd.AddCommand(checkDDLCmd)
+	rootCmd.AddCommand(checkEmailCmd)
+	rootCmd.AddCommand(checkUUIDCmd)
+	rootCmd.AddCommand(checkNullStableCmd)
+	rootCmd.AddCommand(checkSchemaCmd)
+	rootCmd.AddCommand(checkNumericLocaleCmd)
+	rootCmd.AddCommand(checkSchemaTypesCmd)
+	rootCmd.AddCommand(checkTSPrecisionCmd)
+	rootCmd.AddCommand(checkRowCountEqualCmd)
+	rootCmd.AddCommand(checkInAnyReferenceCmd)
+	rootCmd.AddCommand(checkRowCountMatchCmd)
+	rootCmd.AddCommand(checkPositiveCmd)
+	rootCmd.AddCommand(checkNonNegativeCmd)
+	rootCmd.AddCommand(checkPSICmd)
+	rootCmd.AddCommand(checkTrimmedCmd)
+	rootCmd.AddCommand(checkNotBlankCmd)
+	rootCmd.AddCommand(checkEnumSuggestCmd)
+	rootCmd.AddCommand(checkRollingCmd)
+	rootCmd.AddCommand(checkBalancedAssignmentCmd)
+	rootCmd.AddCommand(checkGlobSchemaCmd)
+	rootCmd.AddCommand(checkGrammarCmd)
+	rootCmd.AddCommand(checkInReferenceCmd)
+	rootCmd.AddCommand(checkZScoreCmd)
+	rootCmd.AddCommand(checkIQRCmd)
+	rootCmd.AddCommand(checkMutuallyExclusiveCmd)
+	rootCmd.AddCommand(checkAtLeastOneCmd)
+	rootCmd.AddCommand(checkJSONCmd)
+	rootCmd.AddCommand(checkWholeNumberCmd)
+	rootCmd.AddCommand(checkScaleCmd)
+	rootCmd.AddCommand(checkPrefixCmd)
+	rootCmd.AddCommand(checkSuffixCmd)
+	rootCmd.AddCommand(checkContainsCmd)
+	rootCmd.AddCommand(checkDateBetweenCmd)
+	rootCmd.AddCommand(checkDateNotFutureCmd)
+	rootCmd.AddCommand(checkFreshnessCmd)
 	rootCmd.AddCommand(showLogsCmd)
+	rootCmd.AddCommand(summaryCmd)
 	rootCmd.AddCommand(cleanLogsCmd)
 }
 
-// getChecker initializes a new DataQualityChecker with the configured database path
+// getChecker initializes a new DataQualityChecker with the configured database path, scan options and severity
 func getChecker() *checker.DataQualityChecker {
 	connector := db.NewDBConnector(dbPath)
-	return checker.NewDataQualityChecker(connector)
+	dqChecker := checker.NewDataQualityChecker(connector)
+	// --data-format and --severity were validated before the command ran
+	dqChecker.SetScanOptions(scanOptions)
+	dqChecker.SetSeverity(checker.Severity(severity))
+	return dqChecker
 }
 
 var checkUniqueCmd = &cobra.Command{
 	Use:   "check-unique",
 	Short: "Check if a column contains unique values",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnUnique(dataPath, column)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' is unique.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' is NOT unique.\n", column, dataPath)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is unique.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' is NOT unique.", column, dataPath))
 	},
 }
 
 var checkNotNullCmd = &cobra.Command{
 	Use:   "check-not-null",
 	Short: "Check if a column contains NO null values",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
+		maxFailFraction, _ := cmd.Flags().GetFloat64("max-fail-fraction")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.IsColumnNotNull(dataPath, column)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' has NO nulls.\n", column, dataPath)
+		defer dqChecker.Close()
+		var valid bool
+		var err error
+		if maxFailFraction > 0 {
+			valid, err = dqChecker.IsColumnNotNullWithTolerance(dataPath, column, maxFailFraction)
 		} else {
-			pterm.Error.Printf("Column '%s' in '%s' HAS nulls.\n", column, dataPath)
+			valid, err = dqChecker.IsColumnNotNull(dataPath, column)
 		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' has NO nulls.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' HAS nulls.", column, dataPath))
 	},
 }
 
 var checkEnumCmd = &cobra.Command{
 	Use:   "check-enum",
 	Short: "Check if a column only contains values from a specified list",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		enumValuesStr, _ := cmd.Flags().GetString("enum-values")
+		ignoreCase, _ := cmd.Flags().GetBool("ignore-case")
 
 		if dataPath == "" || column == "" || enumValuesStr == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, --enum-values")
-			return
+			return errors.New("missing required flags: --data, --column, --enum-values")
 		}
 
 		enumValues := strings.Split(enumValuesStr, ",")
@@ -140,31 +276,29 @@ var checkEnumCmd = &cobra.Command{
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.IsColumnEnum(dataPath, column, enumValues)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' contains only allowed values.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' contains invalid values.\n", column, dataPath)
-		}
+		defer dqChecker.Close()
+		detailed, err := dqChecker.IsColumnEnumDetailedIgnoreCase(dataPath, column, enumValues, ignoreCase)
+		offenders := make([]string, len(detailed.Offenders))
+		for i, o := range detailed.Offenders {
+			offenders[i] = fmt.Sprintf("'%s' (%d rows)", o.Value, o.Count)
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, detailed.Passed, err,
+			fmt.Sprintf("Column '%s' in '%s' contains only allowed values.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' contains invalid values: %s", column, dataPath, strings.Join(offenders, ", ")))
 	},
 }
 
 var checkReferencesCmd = &cobra.Command{
 	Use:   "check-references",
 	Short: "Check referential integrity between two files",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		refPath, _ := cmd.Flags().GetString("reference")
 		joinKeysStr, _ := cmd.Flags().GetString("join-keys")
+		emptyAsNull, _ := cmd.Flags().GetBool("empty-as-null")
 
 		if dataPath == "" || refPath == "" || joinKeysStr == "" {
-			pterm.Error.Println("Missing required flags: --data, --reference, --join-keys")
-			return
+			return errors.New("missing required flags: --data, --reference, --join-keys")
 		}
 
 		joinKeys := strings.Split(joinKeysStr, ",")
@@ -173,372 +307,356 @@ var checkReferencesCmd = &cobra.Command{
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.AreTablesReferentialIntegral(dataPath, refPath, joinKeys)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Referential integrity maintained between '%s' and '%s'.\n", dataPath, refPath)
-		} else {
-			pterm.Error.Println("Referential integrity check FAILED.")
-		}
+		defer dqChecker.Close()
+		valid, err := dqChecker.AreTablesReferentialIntegralEmptyAsNull(dataPath, refPath, joinKeys, emptyAsNull)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Referential integrity maintained between '%s' and '%s'.", dataPath, refPath),
+			"Referential integrity check FAILED.")
 	},
 }
 
 var checkColumnExistsCmd = &cobra.Command{
 	Use:   "check-column-exists",
 	Short: "Check if a column exists in the data file",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnInData(dataPath, column)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' exists in '%s'.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' does NOT exist in '%s'.\n", column, dataPath)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' exists in '%s'.", column, dataPath),
+			fmt.Sprintf("Column '%s' does NOT exist in '%s'.", column, dataPath))
 	},
 }
 
 var checkBetweenCmd = &cobra.Command{
 	Use:   "check-between",
 	Short: "Check if column values are within a numeric range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetFloat64("min")
 		max, _ := cmd.Flags().GetFloat64("max")
+		maxFailFraction, _ := cmd.Flags().GetFloat64("max-fail-fraction")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.IsColumnBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' is within range [%v, %v].\n", column, dataPath, min, max)
+		defer dqChecker.Close()
+		var valid bool
+		var err error
+		if maxFailFraction > 0 {
+			valid, err = dqChecker.IsColumnBetweenWithTolerance(dataPath, column, min, max, maxFailFraction)
 		} else {
-			pterm.Error.Printf("Column '%s' in '%s' has values OUTSIDE range [%v, %v].\n", column, dataPath, min, max)
+			valid, err = dqChecker.IsColumnBetween(dataPath, column, min, max)
 		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is within range [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' in '%s' has values OUTSIDE range [%v, %v].", column, dataPath, min, max))
 	},
 }
 
 var checkRegexCmd = &cobra.Command{
 	Use:   "check-regex",
 	Short: "Check if column values match a regex",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		regex, _ := cmd.Flags().GetString("regex")
 
 		if dataPath == "" || column == "" || regex == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, and --regex")
-			return
+			return errors.New("missing required flags: --data, --column, and --regex")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnRegexMatch(dataPath, column, regex)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' matches regex '%s'.\n", column, dataPath, regex)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' does NOT match regex '%s'.\n", column, dataPath, regex)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' matches regex '%s'.", column, dataPath, regex),
+			fmt.Sprintf("Column '%s' in '%s' does NOT match regex '%s'.", column, dataPath, regex))
 	},
 }
 
 var checkTypeCmd = &cobra.Command{
 	Use:   "check-type",
 	Short: "Check if column values match a DuckDB type",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		targetType, _ := cmd.Flags().GetString("type")
 
 		if dataPath == "" || column == "" || targetType == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, and --type")
-			return
+			return errors.New("missing required flags: --data, --column, and --type")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnOfType(dataPath, column, targetType)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' matches type '%s'.\n", column, dataPath, targetType)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' does NOT match type '%s'.\n", column, dataPath, targetType)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' matches type '%s'.", column, dataPath, targetType),
+			fmt.Sprintf("Column '%s' in '%s' does NOT match type '%s'.", column, dataPath, targetType))
 	},
 }
 
 var checkLengthCmd = &cobra.Command{
 	Use:   "check-length",
 	Short: "Check if column value lengths are within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetInt("min")
 		max, _ := cmd.Flags().GetInt("max")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnLengthBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' length in '%s' is within [%d, %d].\n", column, dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Column '%s' length in '%s' is OUTSIDE [%d, %d].\n", column, dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' length in '%s' is within [%d, %d].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' length in '%s' is OUTSIDE [%d, %d].", column, dataPath, min, max))
 	},
 }
 
 var checkMaxCmd = &cobra.Command{
 	Use:   "check-max",
 	Short: "Check if the maximum value in a column is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetFloat64("min")
 		max, _ := cmd.Flags().GetFloat64("max")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnMaxBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' max in '%s' is within [%v, %v].\n", column, dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Column '%s' max in '%s' is OUTSIDE [%v, %v].\n", column, dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' max in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' max in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
 	},
 }
 
 var checkMinCmd = &cobra.Command{
 	Use:   "check-min",
 	Short: "Check if the minimum value in a column is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetFloat64("min")
 		max, _ := cmd.Flags().GetFloat64("max")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnMinBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' min in '%s' is within [%v, %v].\n", column, dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Column '%s' min in '%s' is OUTSIDE [%v, %v].\n", column, dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' min in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' min in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
 	},
 }
 
 var checkMeanCmd = &cobra.Command{
 	Use:   "check-mean",
 	Short: "Check if the mean value in a column is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetFloat64("min")
 		max, _ := cmd.Flags().GetFloat64("max")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnMeanBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' mean in '%s' is within [%v, %v].\n", column, dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Column '%s' mean in '%s' is OUTSIDE [%v, %v].\n", column, dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' mean in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' mean in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
 	},
 }
 
 var checkMedianCmd = &cobra.Command{
 	Use:   "check-median",
 	Short: "Check if the median value in a column is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		min, _ := cmd.Flags().GetFloat64("min")
 		max, _ := cmd.Flags().GetFloat64("max")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnMedianBetween(dataPath, column, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' median in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' median in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
+	},
+}
+
+var checkSumCmd = &cobra.Command{
+	Use:   "check-sum",
+	Short: "Check if the sum of a column is within range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		min, _ := cmd.Flags().GetFloat64("min")
+		max, _ := cmd.Flags().GetFloat64("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
 		}
 
-		if valid {
-			pterm.Success.Printf("Column '%s' median in '%s' is within [%v, %v].\n", column, dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Column '%s' median in '%s' is OUTSIDE [%v, %v].\n", column, dataPath, min, max)
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnSumBetween(dataPath, column, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' sum in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' sum in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
+	},
+}
+
+var checkStdDevCmd = &cobra.Command{
+	Use:   "check-stddev",
+	Short: "Check if the standard deviation of a column is within range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		min, _ := cmd.Flags().GetFloat64("min")
+		max, _ := cmd.Flags().GetFloat64("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnStdDevBetween(dataPath, column, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' stddev in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' stddev in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
+	},
+}
+
+var checkQuantileCmd = &cobra.Command{
+	Use:   "check-quantile",
+	Short: "Check if a quantile of a column is within range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		quantile, _ := cmd.Flags().GetFloat64("quantile")
+		min, _ := cmd.Flags().GetFloat64("min")
+		max, _ := cmd.Flags().GetFloat64("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
 		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnQuantileBetween(dataPath, column, quantile, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' quantile %v in '%s' is within [%v, %v].", column, quantile, dataPath, min, max),
+			fmt.Sprintf("Column '%s' quantile %v in '%s' is OUTSIDE [%v, %v].", column, quantile, dataPath, min, max))
 	},
 }
 
 var checkDateFormatCmd = &cobra.Command{
 	Use:   "check-date-format",
 	Short: "Check if column values match a date format",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		format, _ := cmd.Flags().GetString("format")
 
 		if dataPath == "" || column == "" || format == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, and --format")
-			return
+			return errors.New("missing required flags: --data, --column, and --format")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnDateFormat(dataPath, column, format)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' matches format '%s'.\n", column, dataPath, format)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' does NOT match format '%s'.\n", column, dataPath, format)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' matches format '%s'.", column, dataPath, format),
+			fmt.Sprintf("Column '%s' in '%s' does NOT match format '%s'.", column, dataPath, format))
 	},
 }
 
 var checkRowCountCmd = &cobra.Command{
 	Use:   "check-row-count",
 	Short: "Check if the table row count is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		min, _ := cmd.Flags().GetInt64("min")
 		max, _ := cmd.Flags().GetInt64("max")
 
 		if dataPath == "" {
-			pterm.Error.Println("Missing required flag: --data")
-			return
+			return errors.New("missing required flag: --data")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsTableRowCountBetween(dataPath, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Table '%s' row count is within [%d, %d].\n", dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Table '%s' row count is OUTSIDE [%d, %d].\n", dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Table '%s' row count is within [%d, %d].", dataPath, min, max),
+			fmt.Sprintf("Table '%s' row count is OUTSIDE [%d, %d].", dataPath, min, max))
 	},
 }
 
 var checkColCountCmd = &cobra.Command{
 	Use:   "check-col-count",
 	Short: "Check if the table column count is within range",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		min, _ := cmd.Flags().GetInt("min")
 		max, _ := cmd.Flags().GetInt("max")
 
 		if dataPath == "" {
-			pterm.Error.Println("Missing required flag: --data")
-			return
+			return errors.New("missing required flag: --data")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsTableColumnCountBetween(dataPath, min, max)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Table '%s' column count is within [%d, %d].\n", dataPath, min, max)
-		} else {
-			pterm.Error.Printf("Table '%s' column count is OUTSIDE [%d, %d].\n", dataPath, min, max)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Table '%s' column count is within [%d, %d].", dataPath, min, max),
+			fmt.Sprintf("Table '%s' column count is OUTSIDE [%d, %d].", dataPath, min, max))
 	},
 }
 
 var checkNotInSetCmd = &cobra.Command{
 	Use:   "check-not-in-set",
 	Short: "Check if column values are NOT in a specified list",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		valuesStr, _ := cmd.Flags().GetString("values")
+		ignoreCase, _ := cmd.Flags().GetBool("ignore-case")
 
 		if dataPath == "" || column == "" || valuesStr == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, and --values")
-			return
+			return errors.New("missing required flags: --data, --column, and --values")
 		}
 
 		values := strings.Split(valuesStr, ",")
@@ -547,113 +665,118 @@ var checkNotInSetCmd = &cobra.Command{
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.IsColumnNotInSet(dataPath, column, values)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' contains NO values from the blacklist.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' HAS values from the blacklist.\n", column, dataPath)
-		}
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNotInSetIgnoreCase(dataPath, column, values, ignoreCase)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' contains NO values from the blacklist.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' HAS values from the blacklist.", column, dataPath))
 	},
 }
 
 var checkIncreasingCmd = &cobra.Command{
 	Use:   "check-increasing",
-	Short: "Check if column values are in strictly increasing order",
-	Run: func(cmd *cobra.Command, args []string) {
+	Short: "Check if column values are in increasing order",
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
+		orderBy, _ := cmd.Flags().GetString("order-by")
+		strict, _ := cmd.Flags().GetBool("strict")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.IsColumnIncreasing(dataPath, column)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnMonotonic(dataPath, column, "increasing", strict, orderBy)
+		mode := "strictly increasing"
+		if !strict {
+			mode = "non-decreasing"
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is %s.", column, dataPath, mode),
+			fmt.Sprintf("Column '%s' in '%s' is NOT %s.", column, dataPath, mode))
+	},
+}
 
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' is strictly increasing.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' is NOT strictly increasing.\n", column, dataPath)
+var checkDecreasingCmd = &cobra.Command{
+	Use:   "check-decreasing",
+	Short: "Check if column values are in decreasing order",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		orderBy, _ := cmd.Flags().GetString("order-by")
+		strict, _ := cmd.Flags().GetBool("strict")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
 		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnMonotonic(dataPath, column, "decreasing", strict, orderBy)
+		mode := "strictly decreasing"
+		if !strict {
+			mode = "non-increasing"
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is %s.", column, dataPath, mode),
+			fmt.Sprintf("Column '%s' in '%s' is NOT %s.", column, dataPath, mode))
 	},
 }
 
 var checkDateParseableCmd = &cobra.Command{
 	Use:   "check-date-parseable",
 	Short: "Check if column values are parseable as dates",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 
 		if dataPath == "" || column == "" {
-			pterm.Error.Println("Missing required flags: --data and --column")
-			return
+			return errors.New("missing required flags: --data and --column")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.IsColumnDateParseable(dataPath, column)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Column '%s' in '%s' is date-parseable.\n", column, dataPath)
-		} else {
-			pterm.Error.Printf("Column '%s' in '%s' is NOT date-parseable.\n", column, dataPath)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is date-parseable.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' is NOT date-parseable.", column, dataPath))
 	},
 }
 
 var checkPairEqualCmd = &cobra.Command{
 	Use:   "check-pair-equal",
 	Short: "Check if two columns have equal values in every row",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		col1, _ := cmd.Flags().GetString("col1")
 		col2, _ := cmd.Flags().GetString("col2")
 
 		if dataPath == "" || col1 == "" || col2 == "" {
-			pterm.Error.Println("Missing required flags: --data, --col1, and --col2")
-			return
+			return errors.New("missing required flags: --data, --col1, and --col2")
 		}
 
 		dqChecker := getChecker()
+		defer dqChecker.Close()
 		valid, err := dqChecker.AreColumnPairsEqual(dataPath, col1, col2)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
-		}
-
-		if valid {
-			pterm.Success.Printf("Columns '%s' and '%s' in '%s' are equal in every row.\n", col1, col2, dataPath)
-		} else {
-			pterm.Error.Printf("Columns '%s' and '%s' in '%s' are NOT equal in every row.\n", col1, col2, dataPath)
-		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Columns '%s' and '%s' in '%s' are equal in every row.", col1, col2, dataPath),
+			fmt.Sprintf("Columns '%s' and '%s' in '%s' are NOT equal in every row.", col1, col2, dataPath))
 	},
 }
 
 var checkDistinctInSetCmd = &cobra.Command{
 	Use:   "check-distinct-in-set",
 	Short: "Check if all unique values in a column are in a specified list",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dataPath, _ := cmd.Flags().GetString("data")
 		column, _ := cmd.Flags().GetString("column")
 		valuesStr, _ := cmd.Flags().GetString("values")
+		ignoreCase, _ := cmd.Flags().GetBool("ignore-case")
 
 		if dataPath == "" || column == "" || valuesStr == "" {
-			pterm.Error.Println("Missing required flags: --data, --column, and --values")
-			return
+			return errors.New("missing required flags: --data, --column, and --values")
 		}
 
 		values := strings.Split(valuesStr, ",")
@@ -662,118 +785,1978 @@ var checkDistinctInSetCmd = &cobra.Command{
 		}
 
 		dqChecker := getChecker()
-		valid, err := dqChecker.AreDistinctValuesInSet(dataPath, column, values)
-		if err != nil {
-			pterm.Error.Printf("Error: %v\n", err)
-			return
+		defer dqChecker.Close()
+		valid, err := dqChecker.AreDistinctValuesInSetIgnoreCase(dataPath, column, values, ignoreCase)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All unique values in column '%s' are within the allowed set.", column),
+			fmt.Sprintf("Column '%s' has unique values OUTSIDE the allowed set.", column))
+	},
+}
+
+var checkGroupSumCmd = &cobra.Command{
+	Use:   "check-group-sum",
+	Short: "Check if per-group sums match totals in a reference file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		valueCol, _ := cmd.Flags().GetString("value-column")
+		groupByStr, _ := cmd.Flags().GetString("group-by")
+		refPath, _ := cmd.Flags().GetString("reference")
+		refKey, _ := cmd.Flags().GetString("reference-key")
+		refValue, _ := cmd.Flags().GetString("reference-value")
+		tolerance, _ := cmd.Flags().GetFloat64("tolerance")
+
+		if dataPath == "" || valueCol == "" || groupByStr == "" || refPath == "" || refKey == "" || refValue == "" {
+			return errors.New("missing required flags: --data, --value-column, --group-by, --reference, --reference-key, --reference-value")
 		}
 
-		if valid {
-			pterm.Success.Printf("All unique values in column '%s' are within the allowed set.\n", column)
-		} else {
-			pterm.Error.Printf("Column '%s' has unique values OUTSIDE the allowed set.\n", column)
+		groupBy := strings.Split(groupByStr, ",")
+		for i := range groupBy {
+			groupBy[i] = strings.TrimSpace(groupBy[i])
 		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.GroupSumMatchesReference(dataPath, valueCol, groupBy, refPath, refKey, refValue, tolerance)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Sums of '%s' per group in '%s' match totals in '%s'.", valueCol, dataPath, refPath),
+			fmt.Sprintf("Sums of '%s' per group in '%s' do NOT match totals in '%s'.", valueCol, dataPath, refPath))
 	},
 }
 
-var showLogsCmd = &cobra.Command{
-	Use:   "show-logs",
-	Short: "Show all validation logs from the database",
-	Run: func(cmd *cobra.Command, args []string) {
-		connector := db.NewDBConnector(dbPath)
-		if err := connector.PrintAllLogs(); err != nil {
-			pterm.Error.Printf("Error printing logs: %v\n", err)
+var checkChecksumCmd = &cobra.Command{
+	Use:   "check-checksum",
+	Short: "Check if column values carry a valid check digit",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		algo, _ := cmd.Flags().GetString("algo")
+
+		if dataPath == "" || column == "" || algo == "" {
+			return errors.New("missing required flags: --data, --column, and --algo")
 		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidChecksum(dataPath, column, algo)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' has valid '%s' checksums.", column, dataPath, algo),
+			fmt.Sprintf("Column '%s' in '%s' has INVALID '%s' checksums.", column, dataPath, algo))
 	},
 }
 
-var cleanLogsCmd = &cobra.Command{
-	Use:   "clean-logs",
-	Short: "Clear all validation logs from the database",
-	Run: func(cmd *cobra.Command, args []string) {
-		connector := db.NewDBConnector(dbPath)
-		if err := connector.ClearLogs(); err != nil {
-			pterm.Error.Printf("Error clearing logs: %v\n", err)
-			return
+var checkDistinctStableCmd = &cobra.Command{
+	Use:   "check-distinct-stable",
+	Short: "Check if a column's distinct count has not dropped versus a baseline",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		baselinePath, _ := cmd.Flags().GetString("baseline")
+		column, _ := cmd.Flags().GetString("column")
+		minRatio, _ := cmd.Flags().GetFloat64("min-ratio")
+
+		if dataPath == "" || baselinePath == "" || column == "" {
+			return errors.New("missing required flags: --data, --baseline, and --column")
 		}
-		pterm.Success.Println("Logs cleared successfully.")
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.DistinctCountStable(dataPath, baselinePath, column, minRatio)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' distinct count is stable versus '%s'.", column, dataPath, baselinePath),
+			fmt.Sprintf("Column '%s' in '%s' distinct count DROPPED below %v of '%s'.", column, dataPath, minRatio, baselinePath))
 	},
 }
 
-func init() {
-	checkUniqueCmd.Flags().String("data", "", "Path to the data file")
-	checkUniqueCmd.Flags().String("column", "", "Name of the column to check")
+var checkListElementsCmd = &cobra.Command{
+	Use:   "check-list-elements",
+	Short: "Check if every element of a delimited list column is within a numeric range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		delimiter, _ := cmd.Flags().GetString("delimiter")
+		min, _ := cmd.Flags().GetFloat64("min")
+		max, _ := cmd.Flags().GetFloat64("max")
 
-	checkNotNullCmd.Flags().String("data", "", "Path to the data file")
-	checkNotNullCmd.Flags().String("column", "", "Name of the column to check")
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
 
-	checkEnumCmd.Flags().String("data", "", "Path to the data file")
-	checkEnumCmd.Flags().String("column", "", "Name of the column to check")
-	checkEnumCmd.Flags().String("enum-values", "", "Allowed values (comma-separated)")
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.ListElementsBetween(dataPath, column, delimiter, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All list elements in column '%s' of '%s' are within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' in '%s' has list elements OUTSIDE [%v, %v].", column, dataPath, min, max))
+	},
+}
 
-	checkReferencesCmd.Flags().String("data", "", "Path to the data file")
-	checkReferencesCmd.Flags().String("reference", "", "Path to the reference data file")
-	checkReferencesCmd.Flags().String("join-keys", "", "Column(s) to join on (comma-separated)")
+var checkGitSHACmd = &cobra.Command{
+	Use:   "check-git-sha",
+	Short: "Check if column values are valid git commit SHAs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		full, _ := cmd.Flags().GetBool("full")
 
-	checkColumnExistsCmd.Flags().String("data", "", "Path to the data file")
-	checkColumnExistsCmd.Flags().String("column", "", "Name of the column to check")
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
 
-	checkBetweenCmd.Flags().String("data", "", "Path to the data file")
-	checkBetweenCmd.Flags().String("column", "", "Name of the column to check")
-	checkBetweenCmd.Flags().Float64("min", 0, "Minimum value")
-	checkBetweenCmd.Flags().Float64("max", 0, "Maximum value")
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidGitSHA(dataPath, column, full)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' contains valid git SHAs.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' contains INVALID git SHAs.", column, dataPath))
+	},
+}
 
-	checkRegexCmd.Flags().String("data", "", "Path to the data file")
-	checkRegexCmd.Flags().String("column", "", "Name of the column to check")
-	checkRegexCmd.Flags().String("regex", "", "Regex pattern to match")
+var checkVersionRangesCmd = &cobra.Command{
+	Use:   "check-version-ranges",
+	Short: "Check if per-entity version ranges have no gaps or overlaps",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		entity, _ := cmd.Flags().GetString("entity")
+		from, _ := cmd.Flags().GetString("from")
+		to, _ := cmd.Flags().GetString("to")
 
-	checkTypeCmd.Flags().String("data", "", "Path to the data file")
-	checkTypeCmd.Flags().String("column", "", "Name of the column to check")
-	checkTypeCmd.Flags().String("type", "", "DuckDB type (e.g., INTEGER, VARCHAR, DATE)")
+		if dataPath == "" || entity == "" || from == "" || to == "" {
+			return errors.New("missing required flags: --data, --entity, --from, and --to")
+		}
 
-	checkLengthCmd.Flags().String("data", "", "Path to the data file")
-	checkLengthCmd.Flags().String("column", "", "Name of the column to check")
-	checkLengthCmd.Flags().Int("min", 0, "Minimum length")
-	checkLengthCmd.Flags().Int("max", 0, "Maximum length")
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.VersionRangesContiguous(dataPath, entity, from, to)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Version ranges per '%s' in '%s' are contiguous.", entity, dataPath),
+			fmt.Sprintf("Version ranges per '%s' in '%s' have gaps or overlaps.", entity, dataPath))
+	},
+}
 
-	checkMaxCmd.Flags().String("data", "", "Path to the data file")
-	checkMaxCmd.Flags().String("column", "", "Name of the column to check")
-	checkMaxCmd.Flags().Float64("min", 0, "Minimum allowed max value")
-	checkMaxCmd.Flags().Float64("max", 0, "Maximum allowed max value")
+var checkJSONSafeCmd = &cobra.Command{
+	Use:   "check-json-safe",
+	Short: "Check if column values round-trip through JSON serialization unchanged",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
 
-	checkMinCmd.Flags().String("data", "", "Path to the data file")
-	checkMinCmd.Flags().String("column", "", "Name of the column to check")
-	checkMinCmd.Flags().Float64("min", 0, "Minimum allowed min value")
-	checkMinCmd.Flags().Float64("max", 0, "Maximum allowed min value")
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
 
-	checkMeanCmd.Flags().String("data", "", "Path to the data file")
-	checkMeanCmd.Flags().String("column", "", "Name of the column to check")
-	checkMeanCmd.Flags().Float64("min", 0, "Minimum allowed mean value")
-	checkMeanCmd.Flags().Float64("max", 0, "Maximum allowed mean value")
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnJSONSafe(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is JSON-safe.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' is NOT JSON-safe.", column, dataPath))
+	},
+}
 
-	checkMedianCmd.Flags().String("data", "", "Path to the data file")
-	checkMedianCmd.Flags().String("column", "", "Name of the column to check")
-	checkMedianCmd.Flags().Float64("min", 0, "Minimum allowed median value")
-	checkMedianCmd.Flags().Float64("max", 0, "Maximum allowed median value")
+var checkNoDupColumnsCmd = &cobra.Command{
+	Use:   "check-no-dup-columns",
+	Short: "Check if the file header has no duplicate column names",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
 
-	checkDateFormatCmd.Flags().String("data", "", "Path to the data file")
-	checkDateFormatCmd.Flags().String("column", "", "Name of the column to check")
-	checkDateFormatCmd.Flags().String("format", "", "Date format (strftime)")
+		if dataPath == "" {
+			return errors.New("missing required flag: --data")
+		}
 
-	checkRowCountCmd.Flags().String("data", "", "Path to the data file")
-	checkRowCountCmd.Flags().Int64("min", 0, "Minimum row count")
-	checkRowCountCmd.Flags().Int64("max", 0, "Maximum row count")
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.HasNoDuplicateColumns(dataPath)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Table '%s' has no duplicate column names.", dataPath),
+			fmt.Sprintf("Table '%s' HAS duplicate column names.", dataPath))
+	},
+}
 
-	checkColCountCmd.Flags().String("data", "", "Path to the data file")
-	checkColCountCmd.Flags().Int("min", 0, "Minimum column count")
+var checkSigFigsCmd = &cobra.Command{
+	Use:   "check-sig-figs",
+	Short: "Check if numeric values have at most N significant figures",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		max, _ := cmd.Flags().GetInt("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnSignificantFiguresAtMost(dataPath, column, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' has at most %d significant figures.", column, dataPath, max),
+			fmt.Sprintf("Column '%s' in '%s' has values with MORE than %d significant figures.", column, dataPath, max))
+	},
+}
+
+var checkPathsExistCmd = &cobra.Command{
+	Use:   "check-paths-exist",
+	Short: "Check if file paths listed in a column exist",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		checkURLs, _ := cmd.Flags().GetBool("check-urls")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.AllPathsExist(dataPath, column, checkURLs)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All paths in column '%s' of '%s' exist.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' references MISSING paths.", column, dataPath))
+	},
+}
+
+var checkConditionalEnumCmd = &cobra.Command{
+	Use:   "check-conditional-enum",
+	Short: "Check if each row's value is allowed for its type, using a YAML mapping file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		typeCol, _ := cmd.Flags().GetString("type-column")
+		valueCol, _ := cmd.Flags().GetString("value-column")
+		mappingPath, _ := cmd.Flags().GetString("mapping")
+
+		if dataPath == "" || typeCol == "" || valueCol == "" || mappingPath == "" {
+			return errors.New("missing required flags: --data, --type-column, --value-column, and --mapping")
+		}
+
+		var mapping map[string][]string
+		if err := loadYAMLFile(mappingPath, &mapping); err != nil {
+			return err
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.ConditionalEnum(dataPath, typeCol, valueCol, mapping)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' only contains values allowed for each '%s'.", valueCol, dataPath, typeCol),
+			fmt.Sprintf("Column '%s' in '%s' contains values NOT allowed for their '%s'.", valueCol, dataPath, typeCol))
+	},
+}
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run every check listed in a YAML suite file, or in each suite file of a directory, and print a summary",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		suitePath, _ := cmd.Flags().GetString("suite")
+
+		if suitePath == "" {
+			return errors.New("missing required flags: --suite")
+		}
+
+		// A directory runs each of its suite files, reporting files that fail to parse instead of stopping
+		var files []suite.SuiteFile
+		info, err := os.Stat(suitePath)
+		isDir := err == nil && info.IsDir()
+		if isDir {
+			if files, err = suite.LoadSuiteDir(suitePath); err != nil {
+				return err
+			}
+		} else {
+			s, err := suite.LoadSuite(suitePath)
+			if err != nil {
+				return err
+			}
+			files = []suite.SuiteFile{{Path: suitePath, Suite: s}}
+		}
+
+		header := []string{"#", "Check", "Data", "Result", "Error"}
+		if isDir {
+			header = append([]string{"Suite"}, header...)
+		}
+		tableData := pterm.TableData{header}
+		addRow := func(file string, row ...string) {
+			if isDir {
+				row = append([]string{filepath.Base(file)}, row...)
+			}
+			tableData = append(tableData, row)
+		}
+
+		checks, failed, errored, tolerated, broken := 0, 0, 0, 0, 0
+		var reports []checkReport
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		for _, f := range files {
+			if f.Err != nil {
+				reports = append(reports, checkReport{Suite: f.Path, Error: f.Err.Error()})
+				addRow(f.Path, "-", "-", "-", "ERROR", f.Err.Error())
+				broken++
+				continue
+			}
+			for i, r := range suite.RunSuite(dqChecker, f.Suite) {
+				report := checkReport{Check: r.Spec.Label(), Data: r.Spec.Data, Column: r.Spec.Column, Passed: r.Passed && r.Err == nil, ErrorCount: r.ErrorCount}
+				if !report.Passed {
+					report.Severity = string(r.Severity)
+				}
+				if isDir {
+					report.Suite = f.Path
+				}
+				status, errMsg := "PASS", ""
+				switch {
+				case r.Err != nil:
+					status, errMsg = "ERROR", r.Err.Error()
+					report.Error = errMsg
+					errored++
+				case r.Blocking():
+					status = "FAIL"
+					failed++
+				case !r.Passed:
+					// Failures below error severity are shown but do not fail the run
+					status = pterm.Yellow(strings.ToUpper(string(r.Severity)))
+					tolerated++
+				}
+				checks++
+				reports = append(reports, report)
+				addRow(f.Path, fmt.Sprint(i+1), r.Spec.Label(), r.Spec.Data, status, errMsg)
+			}
+		}
+
+		if outputFormat == outputJSON {
+			if err := printJSON(reports); err != nil {
+				return err
+			}
+		} else {
+			pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		}
+
+		scope := fmt.Sprintf("suite '%s'", suitePath)
+		if isDir {
+			scope = fmt.Sprintf("suite directory '%s'", suitePath)
+		}
+		// The table shows each error, so only the count is printed; in json mode pterm is silenced
+		if broken > 0 {
+			err := fmt.Errorf("%d of %d suite files in '%s' could not be parsed", broken, len(files), suitePath)
+			pterm.Error.Println(err)
+			return reportedError{err}
+		}
+		if errored > 0 {
+			err := fmt.Errorf("%d of %d checks in %s could not run", errored, checks, scope)
+			pterm.Error.Println(err)
+			return reportedError{err}
+		}
+		if failed > 0 {
+			pterm.Error.Printf("%d of %d checks in %s FAILED.\n", failed, checks, scope)
+			return errCheckFailed
+		}
+		if tolerated > 0 {
+			pterm.Warning.Printf("%d of %d checks in %s failed below error severity.\n", tolerated, checks, scope)
+			return nil
+		}
+		pterm.Success.Printf("All %d checks in %s passed.\n", checks, scope)
+		return nil
+	},
+}
+
+var scoreCmd = &cobra.Command{
+	Use:   "score",
+	Short: "Compute a 0-100 data quality score from the weighted checks of a suite file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configPath, _ := cmd.Flags().GetString("config")
+		minScore, _ := cmd.Flags().GetFloat64("min-score")
+
+		if configPath == "" {
+			return errors.New("missing required flag: --config")
+		}
+
+		s, err := suite.LoadSuite(configPath)
+		if err != nil {
+			return err
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsScoreAtLeast(s.Data, suite.ScoreChecks(dqChecker, s), minScore)
+
+		params := dqChecker.LastResultParams()
+		score, _ := params["score"].(float64)
+		if contributions, ok := params["contributions"].([]checker.ScoreContribution); ok && err == nil && outputFormat != outputJSON {
+			tableData := pterm.TableData{{"Check", "Weight", "Result", "Points", "Error"}}
+			for _, contribution := range contributions {
+				status := "FAIL"
+				if contribution.Error != "" {
+					status = "ERROR"
+				} else if contribution.Passed {
+					status = "PASS"
+				}
+				points := fmt.Sprintf("%.1f / %.1f", contribution.Points, contribution.Worth)
+				tableData = append(tableData, []string{contribution.Name, fmt.Sprint(contribution.Weight), status, points, contribution.Error})
+			}
+			pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: configPath}, valid, err,
+			fmt.Sprintf("Data quality score of '%s' is %.1f (minimum %v).", configPath, score, minScore),
+			fmt.Sprintf("Data quality score of '%s' is %.1f, BELOW the minimum %v.", configPath, score, minScore))
+	},
+}
+
+var runRulesCmd = &cobra.Command{
+	Use:   "run-rules",
+	Short: "Evaluate every named SQL rule of a YAML rulebook against a data file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rulesPath, _ := cmd.Flags().GetString("rules")
+		dataPath, _ := cmd.Flags().GetString("data")
+
+		if rulesPath == "" || dataPath == "" {
+			return errors.New("missing required flags: --rules and --data")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		results, err := dqChecker.RunRuleFile(dataPath, rulesPath)
+		if err != nil {
+			return err
+		}
+
+		failed := 0
+		reports := make([]checkReport, 0, len(results))
+		tableData := pterm.TableData{{"#", "Rule", "Result", "Failing Rows"}}
+		for i, r := range results {
+			errorCount := r.ErrorCount
+			reports = append(reports, checkReport{Check: r.Name, Data: dataPath, Passed: r.Passed, ErrorCount: &errorCount})
+			status := "PASS"
+			if !r.Passed {
+				status = "FAIL"
+				failed++
+			}
+			tableData = append(tableData, []string{fmt.Sprint(i + 1), r.Name, status, fmt.Sprint(r.ErrorCount)})
+		}
+		if outputFormat == outputJSON {
+			if err := printJSON(reports); err != nil {
+				return err
+			}
+		} else {
+			pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+		}
+
+		if failed > 0 {
+			return reportFailure(dqChecker.Severity(), fmt.Sprintf("%d of %d rules in '%s' FAILED on '%s'.", failed, len(results), rulesPath, dataPath))
+		}
+		pterm.Success.Printf("All %d rules in '%s' passed on '%s'.\n", len(results), rulesPath, dataPath)
+		return nil
+	},
+}
+
+var checkNumericTypedCmd = &cobra.Command{
+	Use:   "check-numeric-typed",
+	Short: "Check if a column's inferred type is numeric rather than text",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNumericTyped(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' has a numeric type.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' is NOT numerically typed.", column, dataPath))
+	},
+}
+
+var checkJSONPointerCmd = &cobra.Command{
+	Use:   "check-json-pointer",
+	Short: "Check if column values are valid RFC 6901 JSON pointers",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidJSONPointer(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' contains only valid JSON pointers.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' contains INVALID JSON pointers.", column, dataPath))
+	},
+}
+
+var checkChurnCmd = &cobra.Command{
+	Use:   "check-churn",
+	Short: "Check if the fraction of changed rows between two snapshots is below a threshold",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		otherPath, _ := cmd.Flags().GetString("other")
+		key, _ := cmd.Flags().GetString("key")
+		maxChurn, _ := cmd.Flags().GetFloat64("max")
+
+		if dataPath == "" || otherPath == "" || key == "" {
+			return errors.New("missing required flags: --data, --other, and --key")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.RowChurnBelow(dataPath, otherPath, key, maxChurn)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Churn between '%s' and '%s' is at most %v.", dataPath, otherPath, maxChurn),
+			fmt.Sprintf("Churn between '%s' and '%s' EXCEEDS %v.", dataPath, otherPath, maxChurn))
+	},
+}
+
+var checkWordCountCmd = &cobra.Command{
+	Use:   "check-word-count",
+	Short: "Check if the average word count of a text column is within range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		min, _ := cmd.Flags().GetFloat64("min")
+		max, _ := cmd.Flags().GetFloat64("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.AvgWordCountBetween(dataPath, column, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' average word count in '%s' is within [%v, %v].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' average word count in '%s' is OUTSIDE [%v, %v].", column, dataPath, min, max))
+	},
+}
+
+var checkUniqueCompositeCmd = &cobra.Command{
+	Use:   "check-unique-composite",
+	Short: "Check if a combination of columns is unique",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		columnsStr, _ := cmd.Flags().GetString("columns")
+
+		if dataPath == "" || columnsStr == "" {
+			return errors.New("missing required flags: --data and --columns")
+		}
+
+		columns := strings.Split(columnsStr, ",")
+		for i := range columns {
+			columns[i] = strings.TrimSpace(columns[i])
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.AreColumnsUnique(dataPath, columns)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Columns (%s) in '%s' are unique together.", columnsStr, dataPath),
+			fmt.Sprintf("Columns (%s) in '%s' are NOT unique together.", columnsStr, dataPath))
+	},
+}
+
+var checkAllocationRangeCmd = &cobra.Command{
+	Use:   "check-allocation-range",
+	Short: "Check if IDs fall within the block assigned to their source, using a YAML ranges file",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		idCol, _ := cmd.Flags().GetString("id-column")
+		sourceCol, _ := cmd.Flags().GetString("source-column")
+		rangesPath, _ := cmd.Flags().GetString("ranges")
+
+		if dataPath == "" || idCol == "" || sourceCol == "" || rangesPath == "" {
+			return errors.New("missing required flags: --data, --id-column, --source-column, and --ranges")
+		}
+
+		var ranges map[string][2]int64
+		if err := loadYAMLFile(rangesPath, &ranges); err != nil {
+			return err
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnInAllocationRange(dataPath, idCol, sourceCol, ranges)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: idCol}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' only holds IDs from each '%s' block.", idCol, dataPath, sourceCol),
+			fmt.Sprintf("Column '%s' in '%s' holds IDs OUTSIDE their '%s' block.", idCol, dataPath, sourceCol))
+	},
+}
+
+var checkDistinctCountCmd = &cobra.Command{
+	Use:   "check-distinct-count",
+	Short: "Check if the number of distinct values in a column is within range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		min, _ := cmd.Flags().GetInt64("min")
+		max, _ := cmd.Flags().GetInt64("max")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnDistinctCountBetween(dataPath, column, min, max)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' distinct count in '%s' is within [%d, %d].", column, dataPath, min, max),
+			fmt.Sprintf("Column '%s' distinct count in '%s' is OUTSIDE [%d, %d].", column, dataPath, min, max))
+	},
+}
+
+var checkGranularityCmd = &cobra.Command{
+	Use:   "check-granularity",
+	Short: "Check if date values are aligned to a granularity boundary",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		granularity, _ := cmd.Flags().GetString("granularity")
+
+		if dataPath == "" || column == "" || granularity == "" {
+			return errors.New("missing required flags: --data, --column, and --granularity")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnDateGranularity(dataPath, column, granularity)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' is aligned to %s boundaries.", column, dataPath, granularity),
+			fmt.Sprintf("Column '%s' in '%s' has values NOT aligned to %s boundaries.", column, dataPath, granularity))
+	},
+}
+
+var checkValueRateCmd = &cobra.Command{
+	Use:   "check-value-rate",
+	Short: "Check if the share of rows holding a value stays near an expected rate",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		value, _ := cmd.Flags().GetString("value")
+		expected, _ := cmd.Flags().GetFloat64("expected")
+		tolerance, _ := cmd.Flags().GetFloat64("tolerance")
+
+		if dataPath == "" || column == "" || value == "" {
+			return errors.New("missing required flags: --data, --column, and --value")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.ValueRateNear(dataPath, column, value, expected, tolerance)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Rate of '%s' in column '%s' of '%s' is within %v ± %v.", value, column, dataPath, expected, tolerance),
+			fmt.Sprintf("Rate of '%s' in column '%s' of '%s' is OUTSIDE %v ± %v.", value, column, dataPath, expected, tolerance))
+	},
+}
+
+var checkNullFractionCmd = &cobra.Command{
+	Use:   "check-null-fraction",
+	Short: "Check that the share of null values in a column is at most a fraction",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		maxFraction, _ := cmd.Flags().GetFloat64("max-fraction")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNullFractionBelow(dataPath, column, maxFraction)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Null fraction of column '%s' in '%s' is at most %v", column, dataPath, maxFraction),
+			fmt.Sprintf("Null fraction of column '%s' in '%s' exceeds %v", column, dataPath, maxFraction))
+	},
+}
+
+var checkReferenceAttributeCmd = &cobra.Command{
+	Use:   "check-reference-attribute",
+	Short: "Check that matched reference rows have a non-null required attribute",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		joinKey, _ := cmd.Flags().GetString("join-key")
+		refPath, _ := cmd.Flags().GetString("reference")
+		refJoinKey, _ := cmd.Flags().GetString("reference-key")
+		refAttr, _ := cmd.Flags().GetString("attribute")
+
+		if dataPath == "" || joinKey == "" || refPath == "" || refAttr == "" {
+			return errors.New("missing required flags: --data, --join-key, --reference, and --attribute")
+		}
+
+		if refJoinKey == "" {
+			refJoinKey = joinKey
+		}
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.ReferenceAttributeNotNull(dataPath, joinKey, refPath, refJoinKey, refAttr)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: joinKey}, valid, err,
+			fmt.Sprintf("All rows of '%s' reference rows with a non-null '%s'", dataPath, refAttr),
+			fmt.Sprintf("Some rows of '%s' reference rows of '%s' with a null '%s'", dataPath, refPath, refAttr))
+	},
+}
+
+var checkSumsToCmd = &cobra.Command{
+	Use:   "check-sums-to",
+	Short: "Check if a column's total over the file equals a target within a tolerance",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		target, _ := cmd.Flags().GetFloat64("target")
+		tolerance, _ := cmd.Flags().GetFloat64("tolerance")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.ColumnSumsTo(dataPath, column, target, tolerance)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' sums to %v (± %v)", column, dataPath, target, tolerance),
+			fmt.Sprintf("Column '%s' in '%s' does not sum to %v (± %v)", column, dataPath, target, tolerance))
+	},
+}
+
+var checkNoLongRunsCmd = &cobra.Command{
+	Use:   "check-no-long-runs",
+	Short: "Check that no value repeats in too many consecutive rows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		orderBy, _ := cmd.Flags().GetString("order-by")
+		maxRun, _ := cmd.Flags().GetInt("max-run")
+
+		if dataPath == "" || column == "" || maxRun == 0 {
+			return errors.New("missing required flags: --data, --column, and --max-run")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.NoLongRunsOfIdenticalValues(dataPath, column, orderBy, maxRun)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("No value in column '%s' of '%s' repeats more than %d times in a row", column, dataPath, maxRun),
+			fmt.Sprintf("Column '%s' in '%s' has a value repeated more than %d times in a row", column, dataPath, maxRun))
+	},
+}
+
+var checkIdenticalCmd = &cobra.Command{
+	Use:   "check-identical",
+	Short: "Check if two datasets contain exactly the same rows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		otherPath, _ := cmd.Flags().GetString("other")
+		keysStr, _ := cmd.Flags().GetString("keys")
+
+		if dataPath == "" || otherPath == "" {
+			return errors.New("missing required flags: --data and --other")
+		}
+
+		var keys []string
+		if keysStr != "" {
+			keys = strings.Split(keysStr, ",")
+			for i := range keys {
+				keys[i] = strings.TrimSpace(keys[i])
+			}
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.FilesAreIdentical(dataPath, otherPath, keys)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("'%s' is identical to '%s'", dataPath, otherPath),
+			fmt.Sprintf("'%s' differs from '%s'", dataPath, otherPath))
+	},
+}
+
+var checkNoNearZeroCmd = &cobra.Command{
+	Use:   "check-no-near-zero",
+	Short: "Check that no value lies within epsilon of zero",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		epsilon, _ := cmd.Flags().GetFloat64("epsilon")
+		includeZero, _ := cmd.Flags().GetBool("include-zero")
+
+		if dataPath == "" || column == "" || epsilon == 0 {
+			return errors.New("missing required flags: --data, --column, and --epsilon")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.HasNoNearZeroValuesIncludingZero(dataPath, column, epsilon, includeZero)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("No values in column '%s' of '%s' are within %v of zero", column, dataPath, epsilon),
+			fmt.Sprintf("Column '%s' in '%s' has values within %v of zero", column, dataPath, epsilon))
+	},
+}
+
+var checkEpochCmd = &cobra.Command{
+	Use:   "check-epoch",
+	Short: "Check if epoch values in a unit fall within a sane year range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		unit, _ := cmd.Flags().GetString("unit")
+		minYear, _ := cmd.Flags().GetInt("min-year")
+		maxYear, _ := cmd.Flags().GetInt("max-year")
+
+		if dataPath == "" || column == "" || minYear == 0 || maxYear == 0 {
+			return errors.New("missing required flags: --data, --column, --min-year, and --max-year")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidEpoch(dataPath, column, unit, minYear, maxYear)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' holds %s epochs within %d-%d", column, dataPath, unit, minYear, maxYear),
+			fmt.Sprintf("Column '%s' in '%s' has %s epochs outside %d-%d", column, dataPath, unit, minYear, maxYear))
+	},
+}
+
+var checkNoDuplicateRowsCmd = &cobra.Command{
+	Use:   "check-no-duplicate-rows",
+	Short: "Check that no row is duplicated across all columns",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+
+		if dataPath == "" {
+			return errors.New("missing required flag: --data")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.HasNoDuplicateRows(dataPath)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("No fully duplicated rows in '%s'", dataPath),
+			fmt.Sprintf("'%s' contains fully duplicated rows", dataPath))
+	},
+}
+
+var checkMonotonicAfterCmd = &cobra.Command{
+	Use:   "check-monotonic-after",
+	Short: "Check that values strictly increase once they exceed a threshold",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		orderBy, _ := cmd.Flags().GetString("order-by")
+		threshold, _ := cmd.Flags().GetFloat64("threshold")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsMonotonicAfterThreshold(dataPath, column, orderBy, threshold)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' strictly increases after exceeding %v", column, dataPath, threshold),
+			fmt.Sprintf("Column '%s' in '%s' does not strictly increase after exceeding %v", column, dataPath, threshold))
+	},
+}
+
+var checkCompareCmd = &cobra.Command{
+	Use:   "check-compare",
+	Short: "Check that a comparison between two columns holds on every row",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		col1, _ := cmd.Flags().GetString("col1")
+		op, _ := cmd.Flags().GetString("op")
+		col2, _ := cmd.Flags().GetString("col2")
+
+		if dataPath == "" || col1 == "" || op == "" || col2 == "" {
+			return errors.New("missing required flags: --data, --col1, --op, and --col2")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnComparison(dataPath, col1, op, col2)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: col1}, valid, err,
+			fmt.Sprintf("'%s %s %s' holds for every row in '%s'", col1, op, col2, dataPath),
+			fmt.Sprintf("'%s %s %s' fails for some rows in '%s'", col1, op, col2, dataPath))
+	},
+}
+
+var checkQuotingCmd = &cobra.Command{
+	Use:   "check-quoting",
+	Short: "Check that a CSV file has no unbalanced quotes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+
+		if dataPath == "" {
+			return errors.New("missing required flag: --data")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.HasWellFormedQuoting(dataPath)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Quoting in '%s' is well-formed", dataPath),
+			fmt.Sprintf("'%s' has unbalanced or stray quotes", dataPath))
+	},
+}
+
+var checkConditionalCmd = &cobra.Command{
+	Use:   "check-conditional",
+	Short: "Check that rows matching a SQL condition also satisfy a second condition",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		when, _ := cmd.Flags().GetString("when")
+		then, _ := cmd.Flags().GetString("then")
+		allowRawSQL, _ := cmd.Flags().GetBool("allow-raw-sql")
+
+		if dataPath == "" || when == "" || then == "" {
+			return errors.New("missing required flags: --data, --when, and --then")
+		}
+
+		// The predicates are spliced into the query as-is, so running them must be a deliberate choice
+		if !allowRawSQL {
+			return errors.New("--when and --then are run as raw SQL and can read or modify anything DuckDB can reach; pass --allow-raw-sql to confirm they come from a trusted source, or use a conditional check in a suite file")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsConditionTrue(dataPath, when, then)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("All rows in '%s' matching '%s' satisfy '%s'", dataPath, when, then),
+			fmt.Sprintf("Some rows in '%s' matching '%s' do not satisfy '%s'", dataPath, when, then))
+	},
+}
+
+var checkDDLCmd = &cobra.Command{
+	Use:   "check-ddl",
+	Short: "Check if the data's columns match a CREATE TABLE statement",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		ddlFile, _ := cmd.Flags().GetString("ddl-file")
+
+		if dataPath == "" || ddlFile == "" {
+			return errors.New("missing required flags: --data and --ddl-file")
+		}
+
+		ddl, err := os.ReadFile(ddlFile)
+		if err != nil {
+			return fmt.Errorf("failed to read DDL file: %w", err)
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.MatchesDDL(dataPath, string(ddl))
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("'%s' matches the DDL in '%s'", dataPath, ddlFile),
+			fmt.Sprintf("'%s' does not match the DDL in '%s'", dataPath, ddlFile))
+	},
+}
+
+var checkEmailCmd = &cobra.Command{
+	Use:   "check-email",
+	Short: "Check if column values are well-formed email addresses",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidEmail(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are valid email addresses", column),
+			fmt.Sprintf("Column '%s' contains invalid email addresses", column))
+	},
+}
+
+var checkUUIDCmd = &cobra.Command{
+	Use:   "check-uuid",
+	Short: "Check if column values are canonical UUIDs",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidUUID(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are valid UUIDs", column),
+			fmt.Sprintf("Column '%s' contains invalid UUIDs", column))
+	},
+}
+
+var checkNullStableCmd = &cobra.Command{
+	Use:   "check-null-stable",
+	Short: "Check if a column's null rate has not risen versus a baseline",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		baselinePath, _ := cmd.Flags().GetString("baseline")
+		column, _ := cmd.Flags().GetString("column")
+		maxIncrease, _ := cmd.Flags().GetFloat64("max-increase")
+
+		if dataPath == "" || baselinePath == "" || column == "" {
+			return errors.New("missing required flags: --data, --baseline, and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.NullRateStable(dataPath, baselinePath, column, maxIncrease)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' null rate is stable versus '%s'.", column, dataPath, baselinePath),
+			fmt.Sprintf("Column '%s' in '%s' null rate ROSE more than %v above '%s'.", column, dataPath, maxIncrease, baselinePath))
+	},
+}
+
+var checkSchemaCmd = &cobra.Command{
+	Use:   "check-schema",
+	Short: "Check if a file has the expected columns",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		columnsStr, _ := cmd.Flags().GetString("columns")
+		strict, _ := cmd.Flags().GetBool("strict")
+
+		if dataPath == "" || columnsStr == "" {
+			return errors.New("missing required flags: --data and --columns")
+		}
+
+		columns := strings.Split(columnsStr, ",")
+		for i := range columns {
+			columns[i] = strings.TrimSpace(columns[i])
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.DoesSchemaMatch(dataPath, columns, strict)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("'%s' has the expected columns", dataPath),
+			fmt.Sprintf("'%s' does not have the expected columns", dataPath))
+	},
+}
+
+var checkNumericLocaleCmd = &cobra.Command{
+	Use:   "check-numeric-locale",
+	Short: "Check if column values are numbers formatted for a locale",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		locale, _ := cmd.Flags().GetString("locale")
+
+		if dataPath == "" || column == "" || locale == "" {
+			return errors.New("missing required flags: --data, --column, and --locale")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNumericLocale(dataPath, column, locale)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are %s numbers", column, locale),
+			fmt.Sprintf("Column '%s' contains values not formatted as %s numbers", column, locale))
+	},
+}
+
+var checkSchemaTypesCmd = &cobra.Command{
+	Use:   "check-schema-types",
+	Short: "Check if columns have the expected DuckDB types",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		typesStr, _ := cmd.Flags().GetString("types")
+
+		if dataPath == "" || typesStr == "" {
+			return errors.New("missing required flags: --data and --types")
+		}
+
+		types, err := checker.ParseColumnTypes(typesStr)
+		if err != nil {
+			return err
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.DoesSchemaTypesMatch(dataPath, types)
+		var drift []string
+		if params := dqChecker.LastResultParams(); params != nil {
+			drift, _ = params["type_mismatches"].([]string)
+			if missing, _ := params["missing_columns"].([]string); len(missing) > 0 {
+				drift = append(drift, "missing: "+strings.Join(missing, ", "))
+			}
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("All columns in '%s' have the expected types", dataPath),
+			fmt.Sprintf("Columns in '%s' do not have the expected types: %s", dataPath, strings.Join(drift, "; ")))
+	},
+}
+
+var checkTSPrecisionCmd = &cobra.Command{
+	Use:   "check-ts-precision",
+	Short: "Check if timestamps share one fractional-second precision",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnConsistentPrecision(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All timestamps in column '%s' have the same precision", column),
+			fmt.Sprintf("Column '%s' mixes timestamp precisions", column))
+	},
+}
+
+var checkRowCountEqualCmd = &cobra.Command{
+	Use:   "check-row-count-equal",
+	Short: "Check if the table has exactly the expected number of rows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		expected, _ := cmd.Flags().GetInt64("expected")
+
+		if dataPath == "" || !cmd.Flags().Changed("expected") {
+			return errors.New("missing required flags: --data and --expected")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsTableRowCountEqual(dataPath, expected)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Table '%s' has exactly %d rows.", dataPath, expected),
+			fmt.Sprintf("Table '%s' does NOT have exactly %d rows.", dataPath, expected))
+	},
+}
+
+var checkInAnyReferenceCmd = &cobra.Command{
+	Use:   "check-in-any-reference",
+	Short: "Check if every key exists in at least one of several reference files",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		refsPath, _ := cmd.Flags().GetString("references")
+
+		if dataPath == "" || column == "" || refsPath == "" {
+			return errors.New("missing required flags: --data, --column, and --references")
+		}
+
+		refs, err := checker.LoadRefSpecs(refsPath)
+		if err != nil {
+			return err
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsInAnyReference(dataPath, column, refs)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every '%s' in '%s' exists in a reference file.", column, dataPath),
+			fmt.Sprintf("Some '%s' values in '%s' exist in NONE of the reference files.", column, dataPath))
+	},
+}
+
+var checkRowCountMatchCmd = &cobra.Command{
+	Use:   "check-row-count-match",
+	Short: "Check if two files have the same number of rows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		refPath, _ := cmd.Flags().GetString("reference")
+
+		if dataPath == "" || refPath == "" {
+			return errors.New("missing required flags: --data and --reference")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.AreRowCountsEqual(dataPath, refPath)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("'%s' has the same row count as '%s'.", dataPath, refPath),
+			fmt.Sprintf("'%s' row count DIFFERS from '%s'.", dataPath, refPath))
+	},
+}
+
+var checkPositiveCmd = &cobra.Command{
+	Use:   "check-positive",
+	Short: "Check if all values in a column are greater than zero",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnPositive(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are greater than zero.", column),
+			fmt.Sprintf("Column '%s' contains values that are not greater than zero.", column))
+	},
+}
+
+var checkNonNegativeCmd = &cobra.Command{
+	Use:   "check-non-negative",
+	Short: "Check if all values in a column are zero or greater",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNonNegative(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are zero or greater.", column),
+			fmt.Sprintf("Column '%s' contains values that are not zero or greater.", column))
+	},
+}
+
+var checkPSICmd = &cobra.Command{
+	Use:   "check-psi",
+	Short: "Check if a column's distribution has not drifted from a baseline (PSI)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		baselinePath, _ := cmd.Flags().GetString("baseline")
+		column, _ := cmd.Flags().GetString("column")
+		maxPSI, _ := cmd.Flags().GetFloat64("max-psi")
+
+		if dataPath == "" || baselinePath == "" || column == "" {
+			return errors.New("missing required flags: --data, --baseline, and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.PopulationStabilityIndexBelow(dataPath, baselinePath, column, maxPSI)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' distribution is stable versus '%s'.", column, dataPath, baselinePath),
+			fmt.Sprintf("Column '%s' in '%s' distribution DRIFTED beyond PSI %v versus '%s'.", column, dataPath, maxPSI, baselinePath))
+	},
+}
+
+var checkTrimmedCmd = &cobra.Command{
+	Use:   "check-trimmed",
+	Short: "Check if column values have no leading or trailing whitespace",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnTrimmed(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' are trimmed.", column),
+			fmt.Sprintf("Column '%s' contains values with leading or trailing whitespace.", column))
+	},
+}
+
+var checkNotBlankCmd = &cobra.Command{
+	Use:   "check-not-blank",
+	Short: "Check if a column has no null, empty, or whitespace-only values (stricter than check-not-null)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnNotBlank(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' has no null or blank values.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' contains null or blank values.", column, dataPath))
+	},
+}
+
+var checkEnumSuggestCmd = &cobra.Command{
+	Use:   "check-enum-suggest",
+	Short: "Check if a column only contains allowed values, suggesting fixes for typos",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		enumValuesStr, _ := cmd.Flags().GetString("enum-values")
+		maxEditDistance, _ := cmd.Flags().GetInt("max-edit-distance")
+
+		if dataPath == "" || column == "" || enumValuesStr == "" {
+			return errors.New("missing required flags: --data, --column, and --enum-values")
+		}
+
+		enumValues := strings.Split(enumValuesStr, ",")
+		for i := range enumValues {
+			enumValues[i] = strings.TrimSpace(enumValues[i])
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnEnumWithSuggestions(dataPath, column, enumValues, maxEditDistance)
+		failMsg := fmt.Sprintf("Column '%s' in '%s' contains invalid values.", column, dataPath)
+		if params := dqChecker.LastResultParams(); params != nil {
+			suggestions, _ := params["suggestions"].(map[string]string)
+			hints := make([]string, 0, len(suggestions))
+			for value, suggestion := range suggestions {
+				hints = append(hints, fmt.Sprintf("'%s' -> '%s'", value, suggestion))
+			}
+			sort.Strings(hints)
+			if len(hints) > 0 {
+				failMsg += " Did you mean: " + strings.Join(hints, ", ")
+			}
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Column '%s' in '%s' contains only allowed values.", column, dataPath),
+			failMsg)
+	},
+}
+
+var checkRollingCmd = &cobra.Command{
+	Use:   "check-rolling",
+	Short: "Check that no value deviates too far from the rolling mean of the rows before it",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("value")
+		orderBy, _ := cmd.Flags().GetString("order-by")
+		window, _ := cmd.Flags().GetInt("window")
+		maxDeviation, _ := cmd.Flags().GetFloat64("max-deviation")
+
+		if dataPath == "" || column == "" || window == 0 || maxDeviation == 0 {
+			return errors.New("missing required flags: --data, --value, --window, and --max-deviation")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.WithinRollingBounds(dataPath, column, orderBy, window, maxDeviation)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value in column '%s' of '%s' is within %v of its %d-row rolling mean", column, dataPath, maxDeviation, window),
+			fmt.Sprintf("Column '%s' in '%s' has values deviating more than %v from their %d-row rolling mean", column, dataPath, maxDeviation, window))
+	},
+}
+
+var checkBalancedAssignmentCmd = &cobra.Command{
+	Use:   "check-balanced-assignment",
+	Short: "Check that every distinct value of a column holds a roughly equal share of the rows",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		maxImbalance, _ := cmd.Flags().GetFloat64("max-imbalance")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.DistributionIsBalanced(dataPath, column, maxImbalance)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Values of column '%s' in '%s' are balanced within %v.", column, dataPath, maxImbalance),
+			fmt.Sprintf("Column '%s' in '%s' is IMBALANCED: value '%v' holds a share of %.3f.", column, dataPath, dqChecker.LastResultParams()["most_imbalanced_value"], dqChecker.LastResultParams()["most_imbalanced_share"]))
+	},
+}
+
+var checkGlobSchemaCmd = &cobra.Command{
+	Use:   "check-glob-schema",
+	Short: "Check that every file matched by a glob has the same schema",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("path")
+
+		if dataPath == "" {
+			return errors.New("missing required flag: --path")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.GlobSchemaConsistent(dataPath)
+
+		// The glob alone does not say which file drifted, so list each divergent file and how it differs
+		var divergent []string
+		if params := dqChecker.LastResultParams(); params != nil {
+			files, _ := params["divergent_files"].(map[string][]string)
+			for file, differences := range files {
+				divergent = append(divergent, fmt.Sprintf("%s (%s)", file, strings.Join(differences, "; ")))
+			}
+			sort.Strings(divergent)
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("All files matching '%s' share the same schema.", dataPath),
+			fmt.Sprintf("Files matching '%s' have DIVERGENT schemas: %s", dataPath, strings.Join(divergent, ", ")))
+	},
+}
+
+var checkGrammarCmd = &cobra.Command{
+	Use:   "check-grammar",
+	Short: "Check if column values match an EBNF grammar",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		grammarPath, _ := cmd.Flags().GetString("grammar")
+
+		if dataPath == "" || column == "" || grammarPath == "" {
+			return errors.New("missing required flags: --data, --column, and --grammar")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnMatchesGrammar(dataPath, column, grammarPath)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values in column '%s' of '%s' match the grammar in '%s'.", column, dataPath, grammarPath),
+			fmt.Sprintf("Column '%s' in '%s' has values NOT matching the grammar in '%s'.", column, dataPath, grammarPath))
+	},
+}
+
+var checkInReferenceCmd = &cobra.Command{
+	Use:   "check-in-reference",
+	Short: "Check if every value of a column exists in a reference file's column",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		referencePath, _ := cmd.Flags().GetString("reference")
+		referenceColumn, _ := cmd.Flags().GetString("reference-column")
+
+		if dataPath == "" || column == "" || referencePath == "" {
+			return errors.New("missing required flags: --data, --column, and --reference")
+		}
+
+		if referenceColumn == "" {
+			referenceColumn = column
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnSubsetOfReference(dataPath, column, referencePath, referenceColumn)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("All values of column '%s' in '%s' exist in '%s' column '%s'.", column, dataPath, referencePath, referenceColumn),
+			fmt.Sprintf("Column '%s' in '%s' has %v values NOT in '%s' column '%s'.", column, dataPath, dqChecker.LastResultParams()["orphan_values"], referencePath, referenceColumn))
+	},
+}
+
+var checkZScoreCmd = &cobra.Command{
+	Use:   "check-zscore",
+	Short: "Check that no value of a column lies too many standard deviations from the mean",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		maxZ, _ := cmd.Flags().GetFloat64("max-z")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnWithinZScore(dataPath, column, maxZ)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value in column '%s' of '%s' is within %v standard deviations of the mean", column, dataPath, maxZ),
+			fmt.Sprintf("Column '%s' in '%s' has %d values more than %v standard deviations from the mean", column, dataPath, dqChecker.LastResultParams()["error_count"], maxZ))
+	},
+}
+
+var checkIQRCmd = &cobra.Command{
+	Use:   "check-iqr",
+	Short: "Check that no value of a column lies outside the interquartile range fences",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		k, _ := cmd.Flags().GetFloat64("k")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnWithinIQR(dataPath, column, k)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value in column '%s' of '%s' is within %v IQRs of the quartiles", column, dataPath, k),
+			fmt.Sprintf("Column '%s' in '%s' has %d values outside [%v, %v]", column, dataPath, dqChecker.LastResultParams()["error_count"], dqChecker.LastResultParams()["lower_fence"], dqChecker.LastResultParams()["upper_fence"]))
+	},
+}
+
+var checkMutuallyExclusiveCmd = &cobra.Command{
+	Use:   "check-mutually-exclusive",
+	Short: "Check that no row populates both of two columns",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		col1, _ := cmd.Flags().GetString("col1")
+		col2, _ := cmd.Flags().GetString("col2")
+
+		if dataPath == "" || col1 == "" || col2 == "" {
+			return errors.New("missing required flags: --data, --col1, and --col2")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.AreColumnsMutuallyExclusive(dataPath, col1, col2)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Columns '%s' and '%s' in '%s' are never both populated.", col1, col2, dataPath),
+			fmt.Sprintf("Columns '%s' and '%s' in '%s' are both populated in %d rows.", col1, col2, dataPath, dqChecker.LastResultParams()["error_count"]))
+	},
+}
+
+var checkAtLeastOneCmd = &cobra.Command{
+	Use:   "check-at-least-one",
+	Short: "Check that every row populates at least one of several columns",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		columnsStr, _ := cmd.Flags().GetString("columns")
+
+		if dataPath == "" || columnsStr == "" {
+			return errors.New("missing required flags: --data and --columns")
+		}
+
+		columns := strings.Split(columnsStr, ",")
+		for i := range columns {
+			columns[i] = strings.TrimSpace(columns[i])
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsAtLeastOneNotNull(dataPath, columns)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath}, valid, err,
+			fmt.Sprintf("Every row of '%s' populates at least one of %v.", dataPath, columns),
+			fmt.Sprintf("'%s' has %d rows where all of %v are null.", dataPath, dqChecker.LastResultParams()["error_count"], columns))
+	},
+}
+
+var checkJSONCmd = &cobra.Command{
+	Use:   "check-json",
+	Short: "Check if column values parse as JSON",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnValidJSON(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' is valid JSON.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' has %d values that are NOT valid JSON.", column, dataPath, dqChecker.LastResultParams()["error_count"]))
+	},
+}
+
+var checkWholeNumberCmd = &cobra.Command{
+	Use:   "check-whole-number",
+	Short: "Check if column values have no fractional part",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnIsWholeNumber(dataPath, column)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' is a whole number.", column, dataPath),
+			fmt.Sprintf("Column '%s' in '%s' has %d values with a fractional part.", column, dataPath, dqChecker.LastResultParams()["error_count"]))
+	},
+}
+
+var checkScaleCmd = &cobra.Command{
+	Use:   "check-scale",
+	Short: "Check if column values have at most a given number of decimal places",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		maxScale, _ := cmd.Flags().GetInt("max-scale")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnScaleAtMost(dataPath, column, maxScale)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' has at most %d decimal places.", column, dataPath, maxScale),
+			fmt.Sprintf("Column '%s' in '%s' has %d values with more than %d decimal places.", column, dataPath, dqChecker.LastResultParams()["error_count"], maxScale))
+	},
+}
+
+var checkPrefixCmd = &cobra.Command{
+	Use:   "check-prefix",
+	Short: "Check if column values start with a given prefix",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		prefix, _ := cmd.Flags().GetString("prefix")
+
+		if dataPath == "" || column == "" || prefix == "" {
+			return errors.New("missing required flags: --data, --column, and --prefix")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnPrefixed(dataPath, column, prefix)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' starts with '%s'.", column, dataPath, prefix),
+			fmt.Sprintf("Column '%s' in '%s' has %d values NOT starting with '%s'.", column, dataPath, dqChecker.LastResultParams()["error_count"], prefix))
+	},
+}
+
+var checkSuffixCmd = &cobra.Command{
+	Use:   "check-suffix",
+	Short: "Check if column values end with a given suffix",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		suffix, _ := cmd.Flags().GetString("suffix")
+
+		if dataPath == "" || column == "" || suffix == "" {
+			return errors.New("missing required flags: --data, --column, and --suffix")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnSuffixed(dataPath, column, suffix)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' ends with '%s'.", column, dataPath, suffix),
+			fmt.Sprintf("Column '%s' in '%s' has %d values NOT ending with '%s'.", column, dataPath, dqChecker.LastResultParams()["error_count"], suffix))
+	},
+}
+
+var checkContainsCmd = &cobra.Command{
+	Use:   "check-contains",
+	Short: "Check if column values contain a substring, or with --negate that none do",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		substr, _ := cmd.Flags().GetString("substring")
+		negate, _ := cmd.Flags().GetBool("negate")
+
+		if dataPath == "" || column == "" || substr == "" {
+			return errors.New("missing required flags: --data, --column, and --substring")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		if negate {
+			valid, err := dqChecker.IsColumnNotContains(dataPath, column, substr)
+			return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+				fmt.Sprintf("No value of column '%s' in '%s' contains '%s'.", column, dataPath, substr),
+				fmt.Sprintf("Column '%s' in '%s' has %d values containing '%s'.", column, dataPath, dqChecker.LastResultParams()["error_count"], substr))
+		}
+		valid, err := dqChecker.IsColumnContains(dataPath, column, substr)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' contains '%s'.", column, dataPath, substr),
+			fmt.Sprintf("Column '%s' in '%s' has %d values NOT containing '%s'.", column, dataPath, dqChecker.LastResultParams()["error_count"], substr))
+	},
+}
+
+var checkDateBetweenCmd = &cobra.Command{
+	Use:   "check-date-between",
+	Short: "Check if column values are dates within a range",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		minDate, _ := cmd.Flags().GetString("min")
+		maxDate, _ := cmd.Flags().GetString("max")
+
+		if dataPath == "" || column == "" || minDate == "" || maxDate == "" {
+			return errors.New("missing required flags: --data, --column, --min, and --max")
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnDateBetween(dataPath, column, minDate, maxDate)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Every value of column '%s' in '%s' is a date between %s and %s.", column, dataPath, minDate, maxDate),
+			fmt.Sprintf("Column '%s' in '%s' has %v dates outside %s to %s and %v values that are not dates.", column, dataPath, dqChecker.LastResultParams()["out_of_range_count"], minDate, maxDate, dqChecker.LastResultParams()["unparseable_count"]))
+	},
+}
+
+var checkDateNotFutureCmd = &cobra.Command{
+	Use:   "check-date-not-future",
+	Short: "Check that no date or timestamp value lies in the future",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		asOf, _ := cmd.Flags().GetString("as-of")
+
+		if dataPath == "" || column == "" {
+			return errors.New("missing required flags: --data and --column")
+		}
+
+		asOfTime, err := parseLogTime("as-of", asOf, false)
+		if err != nil {
+			return err
+		}
+		if asOfTime.IsZero() {
+			asOfTime = time.Now()
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsColumnDateNotInFutureAsOf(dataPath, column, asOfTime)
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("No value of column '%s' in '%s' is after %s.", column, dataPath, asOfTime.Format(time.RFC3339)),
+			fmt.Sprintf("Column '%s' in '%s' has %d values after %s (latest %v).", column, dataPath, dqChecker.LastResultParams()["error_count"], asOfTime.Format(time.RFC3339), dqChecker.LastResultParams()["latest"]))
+	},
+}
+
+var checkFreshnessCmd = &cobra.Command{
+	Use:   "check-freshness",
+	Short: "Check that the latest timestamp of a column is recent enough",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataPath, _ := cmd.Flags().GetString("data")
+		column, _ := cmd.Flags().GetString("column")
+		maxAgeStr, _ := cmd.Flags().GetString("max-age")
+		asOf, _ := cmd.Flags().GetString("as-of")
+
+		if dataPath == "" || column == "" || maxAgeStr == "" {
+			return errors.New("missing required flags: --data, --column, and --max-age")
+		}
+
+		maxAge, err := time.ParseDuration(maxAgeStr)
+		if err != nil {
+			return fmt.Errorf("invalid --max-age %q: %w", maxAgeStr, err)
+		}
+		asOfTime, err := parseLogTime("as-of", asOf, false)
+		if err != nil {
+			return err
+		}
+		if asOfTime.IsZero() {
+			asOfTime = time.Now()
+		}
+
+		dqChecker := getChecker()
+		defer dqChecker.Close()
+		valid, err := dqChecker.IsDataFreshAsOf(dataPath, column, maxAge, asOfTime)
+		params := dqChecker.LastResultParams()
+		failMsg := fmt.Sprintf("Data in '%s' is STALE: latest '%s' is %v, %v old (max %s).", dataPath, column, params["latest"], params["age"], maxAge)
+		if _, ok := params["latest"]; !ok {
+			failMsg = fmt.Sprintf("Data in '%s' is STALE: column '%s' has no timestamps.", dataPath, column)
+		}
+		return reportCheck(dqChecker, checkReport{Check: cmd.Name(), Data: dataPath, Column: column}, valid, err,
+			fmt.Sprintf("Data in '%s' is fresh: latest '%s' is %v old (max %s).", dataPath, column, params["age"], maxAge),
+			failMsg)
+	},
+}
+
+var showLogsCmd = &cobra.Command{
+	Use:   "show-logs",
+	Short: "Show all validation logs from the database",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checkType, _ := cmd.Flags().GetString("check-type")
+		result, _ := cmd.Flags().GetString("result")
+		since, _ := cmd.Flags().GetString("since")
+		until, _ := cmd.Flags().GetString("until")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		filter := db.LogFilter{CheckType: checkType, Limit: limit}
+		switch result {
+		case "all":
+		case "pass", "fail":
+			passed := result == "pass"
+			filter.Result = &passed
+		default:
+			return fmt.Errorf("invalid --result %q: must be pass, fail, or all", result)
+		}
+		var err error
+		if filter.Since, err = parseLogTime("since", since, false); err != nil {
+			return err
+		}
+		if filter.Until, err = parseLogTime("until", until, true); err != nil {
+			return err
+		}
+		if limit < 0 {
+			return fmt.Errorf("invalid --limit %d: must not be negative", limit)
+		}
+
+		connector := db.NewDBConnector(dbPath)
+		if outputFormat == outputJSON {
+			entries, err := connector.GetLogs(filter)
+			if err != nil {
+				return fmt.Errorf("failed to get logs: %w", err)
+			}
+			if entries == nil {
+				entries = []db.LogEntry{}
+			}
+			return printJSON(entries)
+		}
+		if err := connector.PrintAllLogs(filter); err != nil {
+			return fmt.Errorf("failed to print logs: %w", err)
+		}
+		return nil
+	},
+}
+
+// parseLogTime parses a --since or --until value given as RFC 3339 or as a local date (2006-01-02).
+// A date alone covers the whole day, so for --until (endOfDay) it means the last second of that day.
+// An empty value returns the zero time, which leaves that side of the range open.
+func parseLogTime(flag, value string, endOfDay bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	day, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --%s %q: use RFC 3339 (2006-01-02T15:04:05Z) or a date (2006-01-02)", flag, value)
+	}
+	if endOfDay {
+		day = day.Add(24*time.Hour - time.Second)
+	}
+	return day, nil
+}
+
+var summaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Summarize the log history per check type: runs, passes, failures, pass rate, and last run",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		connector := db.NewDBConnector(dbPath)
+		summaries, err := connector.Summarize()
+		if err != nil {
+			return err
+		}
+
+		if outputFormat == outputJSON {
+			if summaries == nil {
+				summaries = []db.CheckSummary{}
+			}
+			return printJSON(summaries)
+		}
+		if len(summaries) == 0 {
+			pterm.Info.Println("No log entries found.")
+			return nil
+		}
+		tableData := pterm.TableData{{"Check Type", "Runs", "Passed", "Failed", "Pass Rate", "Last Run"}}
+		for _, s := range summaries {
+			tableData = append(tableData, []string{
+				s.CheckType, fmt.Sprint(s.Runs), fmt.Sprint(s.Passed), fmt.Sprint(s.Failed),
+				fmt.Sprintf("%.1f%%", 100*s.PassRate), s.LastRun,
+			})
+		}
+		return pterm.DefaultTable.WithHasHeader().WithData(tableData).Render()
+	},
+}
+
+var cleanLogsCmd = &cobra.Command{
+	Use:   "clean-logs",
+	Short: "Clear all validation logs from the database",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		connector := db.NewDBConnector(dbPath)
+		if err := connector.ClearLogs(); err != nil {
+			return fmt.Errorf("failed to clear logs: %w", err)
+		}
+		if outputFormat == outputJSON {
+			return printJSON(cleanLogsReport{Cleared: true, DBPath: dbPath})
+		}
+		pterm.Success.Println("Logs cleared successfully.")
+		return nil
+	},
+}
+
+func init() {
+	checkUniqueCmd.Flags().String("data", "", "Path to the data file")
+	checkUniqueCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkNotNullCmd.Flags().String("data", "", "Path to the data file")
+	checkNotNullCmd.Flags().String("column", "", "Name of the column to check")
+	checkNotNullCmd.Flags().Float64("max-fail-fraction", 0, "Pass if at most this fraction of rows are null (0 = strict)")
+
+	checkEnumCmd.Flags().String("data", "", "Path to the data file")
+	checkEnumCmd.Flags().String("column", "", "Name of the column to check")
+	checkEnumCmd.Flags().String("enum-values", "", "Allowed values (comma-separated)")
+	checkEnumCmd.Flags().Bool("ignore-case", false, "Compare values case-insensitively")
+
+	checkReferencesCmd.Flags().String("data", "", "Path to the data file")
+	checkReferencesCmd.Flags().String("reference", "", "Path to the reference data file")
+	checkReferencesCmd.Flags().String("join-keys", "", "Column(s) to join on (comma-separated)")
+	checkReferencesCmd.Flags().Bool("empty-as-null", false, "Treat empty-string keys as missing: skip them instead of matching an empty reference key")
+
+	checkColumnExistsCmd.Flags().String("data", "", "Path to the data file")
+	checkColumnExistsCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkBetweenCmd.Flags().String("data", "", "Path to the data file")
+	checkBetweenCmd.Flags().String("column", "", "Name of the column to check")
+	checkBetweenCmd.Flags().Float64("min", 0, "Minimum value")
+	checkBetweenCmd.Flags().Float64("max", 0, "Maximum value")
+	checkBetweenCmd.Flags().Float64("max-fail-fraction", 0, "Pass if at most this fraction of rows are out of range (0 = strict)")
+
+	checkRegexCmd.Flags().String("data", "", "Path to the data file")
+	checkRegexCmd.Flags().String("column", "", "Name of the column to check")
+	checkRegexCmd.Flags().String("regex", "", "Regex pattern to match")
+
+	checkTypeCmd.Flags().String("data", "", "Path to the data file")
+	checkTypeCmd.Flags().String("column", "", "Name of the column to check")
+	checkTypeCmd.Flags().String("type", "", "DuckDB type (e.g., INTEGER, VARCHAR, DATE)")
+
+	checkLengthCmd.Flags().String("data", "", "Path to the data file")
+	checkLengthCmd.Flags().String("column", "", "Name of the column to check")
+	checkLengthCmd.Flags().Int("min", 0, "Minimum length")
+	checkLengthCmd.Flags().Int("max", 0, "Maximum length")
+
+	checkMaxCmd.Flags().String("data", "", "Path to the data file")
+	checkMaxCmd.Flags().String("column", "", "Name of the column to check")
+	checkMaxCmd.Flags().Float64("min", 0, "Minimum allowed max value")
+	checkMaxCmd.Flags().Float64("max", 0, "Maximum allowed max value")
+
+	checkMinCmd.Flags().String("data", "", "Path to the data file")
+	checkMinCmd.Flags().String("column", "", "Name of the column to check")
+	checkMinCmd.Flags().Float64("min", 0, "Minimum allowed min value")
+	checkMinCmd.Flags().Float64("max", 0, "Maximum allowed min value")
+
+	checkMeanCmd.Flags().String("data", "", "Path to the data file")
+	checkMeanCmd.Flags().String("column", "", "Name of the column to check")
+	checkMeanCmd.Flags().Float64("min", 0, "Minimum allowed mean value")
+	checkMeanCmd.Flags().Float64("max", 0, "Maximum allowed mean value")
+
+	checkMedianCmd.Flags().String("data", "", "Path to the data file")
+	checkMedianCmd.Flags().String("column", "", "Name of the column to check")
+	checkMedianCmd.Flags().Float64("min", 0, "Minimum allowed median value")
+	checkMedianCmd.Flags().Float64("max", 0, "Maximum allowed median value")
+
+	checkSumCmd.Flags().String("data", "", "Path to the data file")
+	checkSumCmd.Flags().String("column", "", "Name of the column to check")
+	checkSumCmd.Flags().Float64("min", 0, "Minimum allowed sum")
+	checkSumCmd.Flags().Float64("max", 0, "Maximum allowed sum")
+
+	checkStdDevCmd.Flags().String("data", "", "Path to the data file")
+	checkStdDevCmd.Flags().String("column", "", "Name of the column to check")
+	checkStdDevCmd.Flags().Float64("min", 0, "Minimum allowed standard deviation")
+	checkStdDevCmd.Flags().Float64("max", 0, "Maximum allowed standard deviation")
+
+	checkQuantileCmd.Flags().String("data", "", "Path to the data file")
+	checkQuantileCmd.Flags().String("column", "", "Name of the column to check")
+	checkQuantileCmd.Flags().Float64("quantile", 0.5, "Quantile to compute, within [0, 1]")
+	checkQuantileCmd.Flags().Float64("min", 0, "Minimum allowed quantile value")
+	checkQuantileCmd.Flags().Float64("max", 0, "Maximum allowed quantile value")
+
+	checkDateFormatCmd.Flags().String("data", "", "Path to the data file")
+	checkDateFormatCmd.Flags().String("column", "", "Name of the column to check")
+	checkDateFormatCmd.Flags().String("format", "", "Date format (strftime)")
+
+	checkRowCountCmd.Flags().String("data", "", "Path to the data file")
+	checkRowCountCmd.Flags().Int64("min", 0, "Minimum row count")
+	checkRowCountCmd.Flags().Int64("max", 0, "Maximum row count")
+
+	checkColCountCmd.Flags().String("data", "", "Path to the data file")
+	checkColCountCmd.Flags().Int("min", 0, "Minimum column count")
 	checkColCountCmd.Flags().Int("max", 0, "Maximum column count")
 
 	checkNotInSetCmd.Flags().String("data", "", "Path to the data file")
 	checkNotInSetCmd.Flags().String("column", "", "Name of the column to check")
 	checkNotInSetCmd.Flags().String("values", "", "Blacklisted values (comma-separated)")
+	checkNotInSetCmd.Flags().Bool("ignore-case", false, "Compare values case-insensitively")
 
 	checkIncreasingCmd.Flags().String("data", "", "Path to the data file")
 	checkIncreasingCmd.Flags().String("column", "", "Name of the column to check")
+	checkIncreasingCmd.Flags().String("order-by", "", "Column that defines row order (default: file order)")
+	checkIncreasingCmd.Flags().Bool("strict", true, "Fail on equal consecutive values (--strict=false allows them)")
+
+	checkDecreasingCmd.Flags().String("data", "", "Path to the data file")
+	checkDecreasingCmd.Flags().String("column", "", "Name of the column to check")
+	checkDecreasingCmd.Flags().String("order-by", "", "Column that defines row order (default: file order)")
+	checkDecreasingCmd.Flags().Bool("strict", true, "Fail on equal consecutive values (--strict=false allows them)")
 
 	checkDateParseableCmd.Flags().String("data", "", "Path to the data file")
 	checkDateParseableCmd.Flags().String("column", "", "Name of the column to check")
@@ -785,4 +2768,295 @@ func init() {
 	checkDistinctInSetCmd.Flags().String("data", "", "Path to the data file")
 	checkDistinctInSetCmd.Flags().String("column", "", "Name of the column to check")
 	checkDistinctInSetCmd.Flags().String("values", "", "Allowed values (comma-separated)")
+	checkDistinctInSetCmd.Flags().Bool("ignore-case", false, "Compare values case-insensitively")
+
+	checkGroupSumCmd.Flags().String("data", "", "Path to the data file")
+	checkGroupSumCmd.Flags().String("value-column", "", "Numeric column to sum per group")
+	checkGroupSumCmd.Flags().String("group-by", "", "Column(s) to group by (comma-separated)")
+	checkGroupSumCmd.Flags().String("reference", "", "Path to the reference data file")
+	checkGroupSumCmd.Flags().String("reference-key", "", "Reference key column(s) matching --group-by (comma-separated)")
+	checkGroupSumCmd.Flags().String("reference-value", "", "Reference column holding the expected total")
+	checkGroupSumCmd.Flags().Float64("tolerance", 0, "Maximum allowed absolute difference")
+
+	checkChecksumCmd.Flags().String("data", "", "Path to the data file")
+	checkChecksumCmd.Flags().String("column", "", "Name of the column to check")
+	checkChecksumCmd.Flags().String("algo", "luhn", "Checksum algorithm (luhn, mod11, verhoeff)")
+
+	checkDistinctStableCmd.Flags().String("data", "", "Path to the data file")
+	checkDistinctStableCmd.Flags().String("baseline", "", "Path to the baseline data file")
+	checkDistinctStableCmd.Flags().String("column", "", "Name of the column to check")
+	checkDistinctStableCmd.Flags().Float64("min-ratio", 0.5, "Minimum allowed ratio of current to baseline distinct count")
+
+	checkListElementsCmd.Flags().String("data", "", "Path to the data file")
+	checkListElementsCmd.Flags().String("column", "", "Name of the column to check")
+	checkListElementsCmd.Flags().String("delimiter", ",", "Delimiter separating list elements")
+	checkListElementsCmd.Flags().Float64("min", 0, "Minimum element value")
+	checkListElementsCmd.Flags().Float64("max", 0, "Maximum element value")
+
+	checkGitSHACmd.Flags().String("data", "", "Path to the data file")
+	checkGitSHACmd.Flags().String("column", "", "Name of the column to check")
+	checkGitSHACmd.Flags().Bool("full", false, "Require full 40-character SHAs instead of 7-character short SHAs")
+
+	checkVersionRangesCmd.Flags().String("data", "", "Path to the data file")
+	checkVersionRangesCmd.Flags().String("entity", "", "Entity identifier column")
+	checkVersionRangesCmd.Flags().String("from", "", "Column holding the inclusive range start")
+	checkVersionRangesCmd.Flags().String("to", "", "Column holding the exclusive range end")
+
+	checkJSONSafeCmd.Flags().String("data", "", "Path to the data file")
+	checkJSONSafeCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkNoDupColumnsCmd.Flags().String("data", "", "Path to the data file")
+
+	checkSigFigsCmd.Flags().String("data", "", "Path to the data file")
+	checkSigFigsCmd.Flags().String("column", "", "Name of the column to check")
+	checkSigFigsCmd.Flags().Int("max", 0, "Maximum number of significant figures")
+
+	checkPathsExistCmd.Flags().String("data", "", "Path to the data file")
+	checkPathsExistCmd.Flags().String("column", "", "Name of the column holding file paths")
+	checkPathsExistCmd.Flags().Bool("check-urls", false, "Also HEAD-request http(s) URLs instead of skipping them")
+
+	checkConditionalEnumCmd.Flags().String("data", "", "Path to the data file")
+	checkConditionalEnumCmd.Flags().String("type-column", "", "Column whose value selects the allowed set")
+	checkConditionalEnumCmd.Flags().String("value-column", "", "Column whose values are validated")
+	checkConditionalEnumCmd.Flags().String("mapping", "", "YAML file mapping each type to its allowed values")
+
+	runCmd.Flags().String("suite", "", "Path to the YAML suite file, or a directory of suite files")
+
+	scoreCmd.Flags().String("config", "", "Path to the YAML suite file of weighted checks")
+	scoreCmd.Flags().Float64("min-score", 0, "Minimum passing score, from 0 to 100")
+
+	runRulesCmd.Flags().String("rules", "", "Path to the YAML rulebook mapping rule names to SQL expressions")
+	runRulesCmd.Flags().String("data", "", "Path to data file")
+
+	checkNumericTypedCmd.Flags().String("data", "", "Path to the data file")
+	checkNumericTypedCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkJSONPointerCmd.Flags().String("data", "", "Path to the data file")
+	checkJSONPointerCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkChurnCmd.Flags().String("data", "", "Path to the original snapshot")
+	checkChurnCmd.Flags().String("other", "", "Path to the new snapshot")
+	checkChurnCmd.Flags().String("key", "", "Key column to join snapshots on")
+	checkChurnCmd.Flags().Float64("max", 0, "Maximum allowed fraction of matched rows that changed")
+
+	checkWordCountCmd.Flags().String("data", "", "Path to the data file")
+	checkWordCountCmd.Flags().String("column", "", "Name of the column to check")
+	checkWordCountCmd.Flags().Float64("min", 0, "Minimum allowed average word count")
+	checkWordCountCmd.Flags().Float64("max", 0, "Maximum allowed average word count")
+
+	checkUniqueCompositeCmd.Flags().String("data", "", "Path to the data file")
+	checkUniqueCompositeCmd.Flags().String("columns", "", "Columns forming the composite key (comma-separated)")
+
+	checkAllocationRangeCmd.Flags().String("data", "", "Path to the data file")
+	checkAllocationRangeCmd.Flags().String("id-column", "", "Numeric ID column")
+	checkAllocationRangeCmd.Flags().String("source-column", "", "Column whose value selects the ID block")
+	checkAllocationRangeCmd.Flags().String("ranges", "", "YAML file mapping each source to its inclusive [min, max] ID block")
+
+	checkDistinctCountCmd.Flags().String("data", "", "Path to the data file")
+	checkDistinctCountCmd.Flags().String("column", "", "Name of the column to check")
+	checkDistinctCountCmd.Flags().Int64("min", 0, "Minimum distinct count")
+	checkDistinctCountCmd.Flags().Int64("max", 0, "Maximum distinct count")
+
+	checkGranularityCmd.Flags().String("data", "", "Path to the data file")
+	checkGranularityCmd.Flags().String("column", "", "Name of the column to check")
+	checkGranularityCmd.Flags().String("granularity", "", "Boundary values must align to (hour, day, month, year)")
+
+	checkValueRateCmd.Flags().String("data", "", "Path to the data file")
+	checkValueRateCmd.Flags().String("column", "", "Name of the column to check")
+	checkValueRateCmd.Flags().String("value", "", "Value whose rate is measured")
+	checkValueRateCmd.Flags().Float64("expected", 0, "Expected fraction of rows holding the value")
+	checkValueRateCmd.Flags().Float64("tolerance", 0, "Maximum allowed absolute difference from the expected rate")
+
+	checkNullFractionCmd.Flags().String("data", "", "Path to data file")
+	checkNullFractionCmd.Flags().String("column", "", "Column to check")
+	checkNullFractionCmd.Flags().Float64("max-fraction", 0, "Maximum allowed fraction of null values (0-1)")
+
+	checkReferenceAttributeCmd.Flags().String("data", "", "Path to data file")
+	checkReferenceAttributeCmd.Flags().String("join-key", "", "Join column in the data file")
+	checkReferenceAttributeCmd.Flags().String("reference", "", "Path to reference data file")
+	checkReferenceAttributeCmd.Flags().String("reference-key", "", "Join column in the reference file (defaults to --join-key)")
+	checkReferenceAttributeCmd.Flags().String("attribute", "", "Reference column that must be non-null for matched rows")
+
+	checkSumsToCmd.Flags().String("data", "", "Path to data file")
+	checkSumsToCmd.Flags().String("column", "", "Numeric column to sum")
+	checkSumsToCmd.Flags().Float64("target", 0, "Expected total of the column")
+	checkSumsToCmd.Flags().Float64("tolerance", 0, "Maximum allowed absolute difference from the target")
+
+	checkNoLongRunsCmd.Flags().String("data", "", "Path to data file")
+	checkNoLongRunsCmd.Flags().String("column", "", "Column to check for repeated values")
+	checkNoLongRunsCmd.Flags().String("order-by", "", "Column that orders the rows (defaults to file order)")
+	checkNoLongRunsCmd.Flags().Int("max-run", 0, "Maximum allowed number of consecutive identical values")
+
+	checkIdenticalCmd.Flags().String("data", "", "Path to data file")
+	checkIdenticalCmd.Flags().String("other", "", "Path to the expected data file")
+	checkIdenticalCmd.Flags().String("keys", "", "Comma-separated key columns to match rows on (compares whole rows when empty)")
+
+	checkNoNearZeroCmd.Flags().String("data", "", "Path to data file")
+	checkNoNearZeroCmd.Flags().String("column", "", "Numeric column to check")
+	checkNoNearZeroCmd.Flags().Float64("epsilon", 0, "Values with an absolute value below this are flagged")
+	checkNoNearZeroCmd.Flags().Bool("include-zero", false, "Also flag values that are exactly zero")
+
+	checkEpochCmd.Flags().String("data", "", "Path to data file")
+	checkEpochCmd.Flags().String("column", "", "Epoch column to check")
+	checkEpochCmd.Flags().String("unit", "s", "Epoch unit: s, ms, us, or ns")
+	checkEpochCmd.Flags().Int("min-year", 0, "Earliest allowed year")
+	checkEpochCmd.Flags().Int("max-year", 0, "Latest allowed year")
+
+	checkNoDuplicateRowsCmd.Flags().String("data", "", "Path to data file")
+
+	checkMonotonicAfterCmd.Flags().String("data", "", "Path to data file")
+	checkMonotonicAfterCmd.Flags().String("column", "", "Value column to check")
+	checkMonotonicAfterCmd.Flags().String("order-by", "", "Column that orders the rows (defaults to file order)")
+	checkMonotonicAfterCmd.Flags().Float64("threshold", 0, "Values must strictly increase after first exceeding this")
+
+	checkCompareCmd.Flags().String("data", "", "Path to data file")
+	checkCompareCmd.Flags().String("col1", "", "Left-hand column")
+	checkCompareCmd.Flags().String("op", "", "Comparison operator: >, >=, <, <=, =, or !=")
+	checkCompareCmd.Flags().String("col2", "", "Right-hand column")
+
+	checkQuotingCmd.Flags().String("data", "", "Path to CSV file")
+
+	checkConditionalCmd.Flags().String("data", "", "Path to data file")
+	checkConditionalCmd.Flags().String("when", "", "SQL predicate selecting the rows the rule applies to")
+	checkConditionalCmd.Flags().String("then", "", "SQL predicate every selected row must satisfy")
+	checkConditionalCmd.Flags().Bool("allow-raw-sql", false, "Confirm --when and --then are trusted SQL; they are run verbatim")
+
+	checkDDLCmd.Flags().String("data", "", "Path to data file")
+	checkDDLCmd.Flags().String("ddl-file", "", "Path to a SQL file holding the CREATE TABLE statement")
+
+	checkEmailCmd.Flags().String("data", "", "Path to the data file")
+	checkEmailCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkUUIDCmd.Flags().String("data", "", "Path to the data file")
+	checkUUIDCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkNullStableCmd.Flags().String("data", "", "Path to the data file")
+	checkNullStableCmd.Flags().String("baseline", "", "Path to the baseline data file")
+	checkNullStableCmd.Flags().String("column", "", "Name of the column to check")
+	checkNullStableCmd.Flags().Float64("max-increase", 0.05, "Maximum allowed rise of the null rate, as a fraction (0.05 = 5 percentage points)")
+
+	checkSchemaCmd.Flags().String("data", "", "Path to the data file")
+	checkSchemaCmd.Flags().String("columns", "", "Comma-separated list of expected column names")
+	checkSchemaCmd.Flags().Bool("strict", false, "Require exactly these columns, in this order")
+
+	checkNumericLocaleCmd.Flags().String("data", "", "Path to the data file")
+	checkNumericLocaleCmd.Flags().String("column", "", "Name of the column to check")
+	checkNumericLocaleCmd.Flags().String("locale", "", "Expected number locale: en-US, en-GB, de-DE, de-CH, es-ES, fr-FR, it-IT, nl-NL, or pt-BR")
+
+	checkSchemaTypesCmd.Flags().String("data", "", "Path to the data file")
+	checkSchemaTypesCmd.Flags().String("types", "", "Comma-separated name:TYPE list, e.g. id:BIGINT,price:DECIMAL(10,2)")
+
+	checkTSPrecisionCmd.Flags().String("data", "", "Path to the data file")
+	checkTSPrecisionCmd.Flags().String("column", "", "Name of the timestamp column to check")
+
+	checkRowCountEqualCmd.Flags().String("data", "", "Path to the data file")
+	checkRowCountEqualCmd.Flags().Int64("expected", 0, "Exact number of rows required")
+
+	checkInAnyReferenceCmd.Flags().String("data", "", "Path to the data file")
+	checkInAnyReferenceCmd.Flags().String("column", "", "Name of the key column")
+	checkInAnyReferenceCmd.Flags().String("references", "", "Path to a YAML list of reference files, each with a path and an optional key column")
+
+	checkRowCountMatchCmd.Flags().String("data", "", "Path to the data file")
+	checkRowCountMatchCmd.Flags().String("reference", "", "Path to the file whose row count must match")
+
+	checkPositiveCmd.Flags().String("data", "", "Path to the data file")
+	checkPositiveCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkNonNegativeCmd.Flags().String("data", "", "Path to the data file")
+	checkNonNegativeCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkPSICmd.Flags().String("data", "", "Path to the data file")
+	checkPSICmd.Flags().String("baseline", "", "Path to the baseline data file")
+	checkPSICmd.Flags().String("column", "", "Name of the column to check")
+	checkPSICmd.Flags().Float64("max-psi", 0.2, "Maximum allowed population stability index")
+
+	checkTrimmedCmd.Flags().String("data", "", "Path to the data file")
+	checkTrimmedCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkNotBlankCmd.Flags().String("data", "", "Path to the data file")
+	checkNotBlankCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkEnumSuggestCmd.Flags().String("data", "", "Path to the data file")
+	checkEnumSuggestCmd.Flags().String("column", "", "Name of the column to check")
+	checkEnumSuggestCmd.Flags().String("enum-values", "", "Comma-separated list of allowed values")
+	checkEnumSuggestCmd.Flags().Int("max-edit-distance", 2, "Maximum edit distance for a suggestion")
+
+	checkRollingCmd.Flags().String("data", "", "Path to data file")
+	checkRollingCmd.Flags().String("value", "", "Numeric column to check")
+	checkRollingCmd.Flags().String("order-by", "", "Column that orders the rows (defaults to file order)")
+	checkRollingCmd.Flags().Int("window", 0, "Number of preceding rows in the rolling mean")
+	checkRollingCmd.Flags().Float64("max-deviation", 0, "Maximum allowed absolute deviation from the rolling mean")
+
+	checkBalancedAssignmentCmd.Flags().String("data", "", "Path to data file")
+	checkBalancedAssignmentCmd.Flags().String("column", "", "Column whose values should be evenly distributed, e.g. an experiment variant")
+	checkBalancedAssignmentCmd.Flags().Float64("max-imbalance", 0.05, "Maximum allowed deviation of a value's share from the uniform share")
+
+	checkGlobSchemaCmd.Flags().String("path", "", "Glob matching the files to compare, e.g. 'dir/*.parquet'")
+
+	checkGrammarCmd.Flags().String("data", "", "Path to data file")
+	checkGrammarCmd.Flags().String("column", "", "Column whose values to parse")
+	checkGrammarCmd.Flags().String("grammar", "", "Path to the grammar file; its first rule must match each whole value")
+
+	showLogsCmd.Flags().String("check-type", "", "Only show entries of this check type, e.g. is_column_unique")
+	showLogsCmd.Flags().String("result", "all", "Only show passing or failing entries: pass, fail, or all")
+	showLogsCmd.Flags().String("since", "", "Only show entries at or after this time (RFC 3339 or YYYY-MM-DD)")
+	showLogsCmd.Flags().String("until", "", "Only show entries at or before this time (RFC 3339 or YYYY-MM-DD)")
+	showLogsCmd.Flags().Int("limit", 0, "Only show the most recent N matching entries (0 shows all)")
+
+	checkInReferenceCmd.Flags().String("data", "", "Path to data file")
+	checkInReferenceCmd.Flags().String("column", "", "Column whose values must exist in the reference")
+	checkInReferenceCmd.Flags().String("reference", "", "Path to the reference file")
+	checkInReferenceCmd.Flags().String("reference-column", "", "Column of the reference file (defaults to --column)")
+
+	checkZScoreCmd.Flags().String("data", "", "Path to data file")
+	checkZScoreCmd.Flags().String("column", "", "Column to check")
+	checkZScoreCmd.Flags().Float64("max-z", 3, "Largest absolute z-score allowed")
+
+	checkIQRCmd.Flags().String("data", "", "Path to data file")
+	checkIQRCmd.Flags().String("column", "", "Column to check")
+	checkIQRCmd.Flags().Float64("k", 1.5, "IQR multiplier for the fences")
+
+	checkMutuallyExclusiveCmd.Flags().String("data", "", "Path to data file")
+	checkMutuallyExclusiveCmd.Flags().String("col1", "", "First column name")
+	checkMutuallyExclusiveCmd.Flags().String("col2", "", "Second column name")
+
+	checkAtLeastOneCmd.Flags().String("data", "", "Path to data file")
+	checkAtLeastOneCmd.Flags().String("columns", "", "Columns of which at least one must be non-null (comma-separated)")
+
+	checkJSONCmd.Flags().String("data", "", "Path to data file")
+	checkJSONCmd.Flags().String("column", "", "Column holding serialized JSON")
+
+	checkWholeNumberCmd.Flags().String("data", "", "Path to data file")
+	checkWholeNumberCmd.Flags().String("column", "", "Name of the column to check")
+
+	checkScaleCmd.Flags().String("data", "", "Path to data file")
+	checkScaleCmd.Flags().String("column", "", "Name of the column to check")
+	checkScaleCmd.Flags().Int("max-scale", 2, "Maximum number of fractional digits")
+
+	checkPrefixCmd.Flags().String("data", "", "Path to data file")
+	checkPrefixCmd.Flags().String("column", "", "Name of the column to check")
+	checkPrefixCmd.Flags().String("prefix", "", "Prefix every value must start with")
+
+	checkSuffixCmd.Flags().String("data", "", "Path to data file")
+	checkSuffixCmd.Flags().String("column", "", "Name of the column to check")
+	checkSuffixCmd.Flags().String("suffix", "", "Suffix every value must end with")
+
+	checkContainsCmd.Flags().String("data", "", "Path to data file")
+	checkContainsCmd.Flags().String("column", "", "Name of the column to check")
+	checkContainsCmd.Flags().String("substring", "", "Substring to look for")
+	checkContainsCmd.Flags().Bool("negate", false, "Require that no value contains the substring")
+
+	checkDateBetweenCmd.Flags().String("data", "", "Path to data file")
+	checkDateBetweenCmd.Flags().String("column", "", "Name of the column to check")
+	checkDateBetweenCmd.Flags().String("min", "", "Earliest allowed date (YYYY-MM-DD)")
+	checkDateBetweenCmd.Flags().String("max", "", "Latest allowed date (YYYY-MM-DD)")
+
+	checkDateNotFutureCmd.Flags().String("data", "", "Path to data file")
+	checkDateNotFutureCmd.Flags().String("column", "", "Name of the date or timestamp column")
+	checkDateNotFutureCmd.Flags().String("as-of", "", "Reference time instead of now, RFC 3339 or a date (2006-01-02)")
+
+	checkFreshnessCmd.Flags().String("data", "", "Path to data file")
+	checkFreshnessCmd.Flags().String("column", "", "Timestamp column whose latest value is checked")
+	checkFreshnessCmd.Flags().String("max-age", "", "Largest allowed age of the latest timestamp, e.g. 24h or 90m")
+	checkFreshnessCmd.Flags().String("as-of", "", "Reference time instead of now, RFC 3339 or a date (2006-01-02)")
 }
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/josephmachado/data_quality_checker/internal/db"
+	"github.com/pterm/pterm"
+)
+
+func TestExitCodes(t *testing.T) {
+	dataDir, err := filepath.Abs(filepath.Join("..", "..", "tests", "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Keep any local .dqc.yaml from leaking into the test
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	dbArg := "--db-path=" + filepath.Join(t.TempDir(), "test.db")
+
+	// Cobra keeps flag values between Execute calls, so every case sets the flags it relies on
+	// and the missing-flags case runs first.
+	cases := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"MissingFlags", []string{"check-unique", dbArg}, exitError},
+		{"Passing", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "unique_data.csv"), "--column", "id"}, 0},
+		{"Failing", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "duplicate_data.csv"), "--column", "id"}, exitCheckFailed},
+		{"MissingFile", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "missing.csv"), "--column", "id"}, exitError},
+		{"RawSQLNotAllowed", []string{"check-conditional", dbArg, "--data", filepath.Join(dataDir, "unique_data.csv"), "--when", "id > 0", "--then", "id < 100"}, exitError},
+		{"RawSQLAllowed", []string{"check-conditional", dbArg, "--data", filepath.Join(dataDir, "unique_data.csv"), "--when", "id > 0", "--then", "id < 100", "--allow-raw-sql"}, 0},
+		{"InvalidSeverity", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "duplicate_data.csv"), "--column", "id", "--severity", "fatal"}, exitError},
+		{"WarnSeverityFailure", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "duplicate_data.csv"), "--column", "id", "--severity", "warn"}, 0},
+		{"ErrorSeverityFailure", []string{"check-unique", dbArg, "--data", filepath.Join(dataDir, "duplicate_data.csv"), "--column", "id", "--severity", "error"}, exitCheckFailed},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rootCmd.SetArgs(tc.args)
+			got := 0
+			if err := rootCmd.Execute(); err != nil {
+				got = exitCode(err)
+			}
+			if got != tc.want {
+				t.Errorf("Expected exit code %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestJSONOutput(t *testing.T) {
+	dataDir, err := filepath.Abs(filepath.Join("..", "..", "tests", "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	dbArg := "--db-path=" + filepath.Join(t.TempDir(), "test.db")
+
+	var buf bytes.Buffer
+	stdout = &buf
+	t.Cleanup(func() {
+		stdout = os.Stdout
+		outputFormat = outputText
+		pterm.EnableOutput()
+	})
+
+	rootCmd.SetArgs([]string{"check-unique", dbArg, "--output", "json", "--data", filepath.Join(dataDir, "duplicate_data.csv"), "--column", "id"})
+	err = rootCmd.Execute()
+	if got := exitCode(err); got != exitCheckFailed {
+		t.Fatalf("Expected exit code %d, got %d (err: %v)", exitCheckFailed, got, err)
+	}
+
+	var report checkReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Expected a JSON report, got %q: %v", buf.String(), err)
+	}
+	if report.Check != "check-unique" || report.Column != "id" || report.Passed {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+	if report.ErrorCount == nil || *report.ErrorCount == 0 {
+		t.Errorf("Expected a non-zero error_count, got %v", report.ErrorCount)
+	}
+
+	buf.Reset()
+	rootCmd.SetArgs([]string{"check-unique", dbArg, "--output", "json", "--data", filepath.Join(dataDir, "missing.csv"), "--column", "id"})
+	err = rootCmd.Execute()
+	if got := exitCode(err); got != exitError {
+		t.Fatalf("Expected exit code %d, got %d", exitError, got)
+	}
+	report = checkReport{}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Expected a JSON report, got %q: %v", buf.String(), err)
+	}
+	if report.Error == "" || report.Passed {
+		t.Errorf("Expected an error report, got %+v", report)
+	}
+
+	// Log commands print JSON too; only the failing check-unique run was logged
+	showLogs := func() []db.LogEntry {
+		buf.Reset()
+		rootCmd.SetArgs([]string{"show-logs", dbArg, "--output", "json", "--result", "all"})
+		if err := rootCmd.Execute(); err != nil {
+			t.Fatalf("show-logs failed: %v", err)
+		}
+		var entries []db.LogEntry
+		if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+			t.Fatalf("Expected JSON log entries, got %q: %v", buf.String(), err)
+		}
+		return entries
+	}
+	if entries := showLogs(); len(entries) != 1 || entries[0].DataQualityCheckType != "is_column_unique" || entries[0].Result {
+		t.Errorf("Expected the failing uniqueness entry, got %+v", entries)
+	}
+
+	buf.Reset()
+	rootCmd.SetArgs([]string{"clean-logs", dbArg, "--output", "json"})
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("clean-logs failed: %v", err)
+	}
+	var cleaned cleanLogsReport
+	if err := json.Unmarshal(buf.Bytes(), &cleaned); err != nil || !cleaned.Cleared {
+		t.Fatalf("Expected a JSON clean-logs result, got %q: %v", buf.String(), err)
+	}
+	if entries := showLogs(); entries == nil || len(entries) != 0 {
+		t.Errorf("Expected an empty JSON array after clean-logs, got %+v", entries)
+	}
+}
+
+func TestRunSuiteDirectory(t *testing.T) {
+	dataDir, err := filepath.Abs(filepath.Join("..", "..", "tests", "data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	dbArg := "--db-path=" + filepath.Join(t.TempDir(), "test.db")
+
+	suites := t.TempDir()
+	writeSuite := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(suites, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run := func(args ...string) int {
+		rootCmd.SetArgs(append([]string{"run", dbArg, "--suite", suites}, args...))
+		if err := rootCmd.Execute(); err != nil {
+			return exitCode(err)
+		}
+		return 0
+	}
+	writeSuite("unique.yaml", "checks:\n  - type: unique\n    data: "+filepath.Join(dataDir, "unique_data.csv")+"\n    column: id\n")
+
+	if got := run(); got != 0 {
+		t.Errorf("Expected a passing directory to exit 0, got %d", got)
+	}
+
+	writeSuite("duplicates.yml", "checks:\n  - type: unique\n    data: "+filepath.Join(dataDir, "duplicate_data.csv")+"\n    column: id\n")
+	if got := run(); got != exitCheckFailed {
+		t.Errorf("Expected a failing check in any file to exit %d, got %d", exitCheckFailed, got)
+	}
+
+	// A file that fails to parse is reported with its name while the other files still run
+	writeSuite("broken.yaml", "checks:\n  - type: nope\n")
+	var buf bytes.Buffer
+	stdout = &buf
+	t.Cleanup(func() {
+		stdout = os.Stdout
+		outputFormat = outputText
+		pterm.EnableOutput()
+	})
+	if got := run("--output", "json"); got != exitError {
+		t.Errorf("Expected a broken suite file to exit %d, got %d", exitError, got)
+	}
+
+	var reports []checkReport
+	if err := json.Unmarshal(buf.Bytes(), &reports); err != nil {
+		t.Fatalf("Expected a JSON report, got %q: %v", buf.String(), err)
+	}
+	if len(reports) != 3 {
+		t.Fatalf("Expected the broken file and both checks to be reported, got %+v", reports)
+	}
+	if filepath.Base(reports[0].Suite) != "broken.yaml" || reports[0].Error == "" {
+		t.Errorf("Expected the parse error to name broken.yaml, got %+v", reports[0])
+	}
+	if filepath.Base(reports[2].Suite) != "unique.yaml" || !reports[2].Passed {
+		t.Errorf("Expected unique.yaml to still run and pass, got %+v", reports[2])
+	}
+	// As for single checks, severity is reported only for checks that did not pass
+	if reports[1].Passed || reports[1].Severity != "error" || reports[2].Severity != "" {
+		t.Errorf("Expected severity on the failing check only, got %+v and %+v", reports[1], reports[2])
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/josephmachado/data_quality_checker/internal/checker"
+	"github.com/pterm/pterm"
+)
+
+// Supported values of the --output flag
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+var outputFormat string
+
+// stdout is where JSON output is written; tests replace it to capture reports
+var stdout io.Writer = os.Stdout
+
+// checkReport is the machine-readable outcome of one check, printed in --output json mode
+type checkReport struct {
+	Check      string `json:"check"`
+	Data       string `json:"data"`
+	Column     string `json:"column,omitempty"`
+	Passed     bool   `json:"passed"`
+	ErrorCount *int64 `json:"error_count,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Severity is set for failed checks, whose exit code depends on it
+	Severity string `json:"severity,omitempty"`
+	// Suite is the suite file of the check when run runs a directory of suite files
+	Suite string `json:"suite,omitempty"`
+}
+
+// cleanLogsReport is the outcome of clean-logs, printed in --output json mode
+type cleanLogsReport struct {
+	Cleared bool   `json:"cleared"`
+	DBPath  string `json:"db_path"`
+}
+
+// reportedError wraps an error that was already printed as JSON, so main only maps it to an exit code
+type reportedError struct {
+	err error
+}
+
+func (e reportedError) Error() string { return e.err.Error() }
+func (e reportedError) Unwrap() error { return e.err }
+
+// applyOutputFormat validates --output and silences pterm in json mode so only JSON reaches stdout
+func applyOutputFormat() error {
+	switch outputFormat {
+	case outputText:
+		pterm.EnableOutput()
+	case outputJSON:
+		pterm.DisableOutput()
+	default:
+		return fmt.Errorf("invalid --output %q: must be %q or %q", outputFormat, outputText, outputJSON)
+	}
+	return nil
+}
+
+// reportCheck prints a check outcome in the selected output format and returns the command's error:
+// nil when the check passed, errCheckFailed when it failed, and the check's own error otherwise.
+// A failure below error severity (see --severity) is reported as a warning or info message and returns nil.
+// In text mode passMsg or failMsg is printed; in json mode a checkReport is printed instead.
+func reportCheck(dqChecker *checker.DataQualityChecker, report checkReport, passed bool, err error, passMsg, failMsg string) error {
+	severity := dqChecker.Severity()
+	if outputFormat != outputJSON {
+		if err != nil {
+			return err
+		}
+		if !passed {
+			return reportFailure(severity, failMsg)
+		}
+		pterm.Success.Println(passMsg)
+		return nil
+	}
+
+	report.Passed = passed && err == nil
+	if err != nil {
+		report.Error = err.Error()
+	} else if count, ok := dqChecker.LastErrorCount(); ok {
+		report.ErrorCount = &count
+	}
+	if !report.Passed {
+		report.Severity = string(severity)
+	}
+	if printErr := printJSON(report); printErr != nil {
+		return printErr
+	}
+
+	if err != nil {
+		return reportedError{err}
+	}
+	if !passed && severity == checker.SeverityError {
+		return errCheckFailed
+	}
+	return nil
+}
+
+// reportFailure prints a failed check's message in the color of its severity and returns errCheckFailed
+// only for error severity, so warn and info failures exit 0
+func reportFailure(severity checker.Severity, failMsg string) error {
+	switch severity {
+	case checker.SeverityWarn:
+		pterm.Warning.Println(failMsg)
+	case checker.SeverityInfo:
+		pterm.Info.Println(failMsg)
+	default:
+		pterm.Error.Println(failMsg)
+		return errCheckFailed
+	}
+	return nil
+}
+
+// printJSON writes v to stdout as indented JSON
+func printJSON(v interface{}) error {
+	encoder := json.NewEncoder(stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
@@ -2,17 +2,65 @@ package checker
 
 import (
 	"database/sql"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/josephmachado/data_quality_checker/internal/db"
 	_ "github.com/marcboeker/go-duckdb"
+	"gopkg.in/yaml.v3"
 )
 
+// maxLoggedOffenders caps how many offending values a check records in its log params
+const maxLoggedOffenders = 10
+
+// maxSampleRows caps how many failing rows detailed checks return and log
+const maxSampleRows = 5
+
+// CheckResult is the outcome of a detailed check, including a sample of the rows that failed it
+type CheckResult struct {
+	Passed     bool
+	ErrorCount int64
+	Samples    []map[string]interface{}
+	// Offenders histograms the failing values by row count, for checks that report them
+	Offenders []ValueCount
+}
+
+// ValueCount is one failing value and the number of rows holding it
+type ValueCount struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
 // DataQualityChecker provides methods to perform various data quality checks
 type DataQualityChecker struct {
 	dbConnector *db.DBConnector
+
+	// duckDB is shared by all checks and opened lazily on first use
+	duckDB *sql.DB
+	duckMu sync.Mutex
+	// httpfsLoaded records whether httpfs and S3 credentials were set up on duckDB
+	httpfsLoaded bool
+	// postgresLoaded records whether the postgres extension was loaded on duckDB
+	postgresLoaded bool
+
+	// lastParams holds the params logged by the most recent check
+	lastParams map[string]interface{}
+
+	// scanOptions controls how data files are read (see SetScanOptions)
+	scanOptions ScanOptions
+	// severity is logged with every check result (see SetSeverity)
+	severity Severity
 }
 
 // NewDataQualityChecker creates a new DataQualityChecker
@@ -20,21 +68,469 @@ func NewDataQualityChecker(dbConnector *db.DBConnector) *DataQualityChecker {
 	return &DataQualityChecker{dbConnector: dbConnector}
 }
 
-// validatePathExists checks if file exists and is readable by DuckDB
+// getDuckDB returns the checker's shared in-memory DuckDB handle, opening it on first use.
+// Reusing one handle avoids re-initializing DuckDB for every check in a suite.
+func (c *DataQualityChecker) getDuckDB() (*sql.DB, error) {
+	c.duckMu.Lock()
+	defer c.duckMu.Unlock()
+
+	if c.duckDB == nil {
+		duckDB, err := sql.Open("duckdb", "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to open duckdb: %w", err)
+		}
+		c.duckDB = duckDB
+	}
+	return c.duckDB, nil
+}
+
+// Close releases the shared DuckDB handle. The checker remains usable and reopens DuckDB on the next check.
+func (c *DataQualityChecker) Close() error {
+	c.duckMu.Lock()
+	defer c.duckMu.Unlock()
+
+	if c.duckDB == nil {
+		return nil
+	}
+	err := c.duckDB.Close()
+	c.duckDB = nil
+	c.httpfsLoaded = false
+	c.postgresLoaded = false
+	return err
+}
+
+// remotePathPrefixes are the URL schemes read through DuckDB's httpfs extension instead of the local filesystem
+var remotePathPrefixes = []string{"s3://", "s3a://", "s3n://", "gs://", "gcs://", "r2://", "http://", "https://"}
+
+// isRemotePath reports whether dataPath is a URL (e.g. s3://bucket/data.parquet) rather than a local file
+func isRemotePath(dataPath string) bool {
+	lower := strings.ToLower(dataPath)
+	for _, prefix := range remotePathPrefixes {
+		if strings.HasPrefix(lower, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadHTTPFS installs and loads DuckDB's httpfs extension once per DuckDB handle and registers S3 credentials
+// from the standard AWS environment variables. It is only called for remote paths, so local-only runs never
+// need network access to fetch the extension.
+func (c *DataQualityChecker) loadHTTPFS(duckInfo *sql.DB) error {
+	c.duckMu.Lock()
+	defer c.duckMu.Unlock()
+
+	if c.httpfsLoaded {
+		return nil
+	}
+	if _, err := duckInfo.Exec("INSTALL httpfs; LOAD httpfs;"); err != nil {
+		return fmt.Errorf("failed to load duckdb httpfs extension: %w", err)
+	}
+	if secret := s3SecretStatement(os.Getenv); secret != "" {
+		if _, err := duckInfo.Exec(secret); err != nil {
+			return fmt.Errorf("failed to configure S3 credentials: %w", err)
+		}
+	}
+	c.httpfsLoaded = true
+	return nil
+}
+
+// s3SecretStatement builds a DuckDB CREATE SECRET statement from AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY,
+// AWS_SESSION_TOKEN, AWS_REGION (or AWS_DEFAULT_REGION) and AWS_ENDPOINT_URL as read through getenv.
+// It returns an empty string when no access key is set, leaving public buckets and HTTP(S) URLs readable anonymously.
+func s3SecretStatement(getenv func(string) string) string {
+	keyID := getenv("AWS_ACCESS_KEY_ID")
+	if keyID == "" {
+		return ""
+	}
+
+	options := []string{
+		"TYPE S3",
+		"KEY_ID " + quoteLiteral(keyID),
+		"SECRET " + quoteLiteral(getenv("AWS_SECRET_ACCESS_KEY")),
+	}
+	if token := getenv("AWS_SESSION_TOKEN"); token != "" {
+		options = append(options, "SESSION_TOKEN "+quoteLiteral(token))
+	}
+	region := getenv("AWS_REGION")
+	if region == "" {
+		region = getenv("AWS_DEFAULT_REGION")
+	}
+	if region != "" {
+		options = append(options, "REGION "+quoteLiteral(region))
+	}
+	// DuckDB expects a bare host for S3-compatible endpoints such as MinIO, with TLS toggled separately
+	if endpoint := getenv("AWS_ENDPOINT_URL"); endpoint != "" {
+		if strings.HasPrefix(endpoint, "http://") {
+			options = append(options, "USE_SSL false")
+		}
+		endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "https://"), "http://")
+		options = append(options, "ENDPOINT "+quoteLiteral(endpoint), "URL_STYLE 'path'")
+	}
+	return fmt.Sprintf("CREATE OR REPLACE SECRET dqc_s3 (%s)", strings.Join(options, ", "))
+}
+
+// logResult writes a check's outcome to the log and remembers its params for LastResultParams.
+// start is when the check began, so the logged duration covers the whole check, including any checks it runs.
+func (c *DataQualityChecker) logResult(checkType string, result bool, params map[string]interface{}, start time.Time) error {
+	return c.logResultWithDuration(checkType, result, params, time.Since(start))
+}
+
+// logResultWithDuration is like logResult but logs a duration measured by the caller
+func (c *DataQualityChecker) logResultWithDuration(checkType string, result bool, params map[string]interface{}, duration time.Duration) error {
+	c.lastParams = params
+	return c.dbConnector.LogWithSeverity(checkType, result, params, duration, string(c.Severity()))
+}
+
+// Severity is how much a check's failure matters. Only error-severity failures are meant to fail a pipeline;
+// warn and info failures are logged and reported but tolerated.
+type Severity string
+
+// Supported severities
+const (
+	SeverityError Severity = "error"
+	SeverityWarn  Severity = "warn"
+	SeverityInfo  Severity = "info"
+)
+
+// ParseSeverity returns the Severity named by s; an empty string is SeverityError
+func ParseSeverity(s string) (Severity, error) {
+	switch severity := Severity(s); severity {
+	case "":
+		return SeverityError, nil
+	case SeverityError, SeverityWarn, SeverityInfo:
+		return severity, nil
+	}
+	return "", fmt.Errorf("unsupported severity %q: must be %s, %s or %s", s, SeverityError, SeverityWarn, SeverityInfo)
+}
+
+// Severity returns the severity logged with check results, SeverityError unless SetSeverity chose another
+func (c *DataQualityChecker) Severity() Severity {
+	if c.severity == "" {
+		return SeverityError
+	}
+	return c.severity
+}
+
+// SetSeverity sets the severity logged with every subsequent check result
+func (c *DataQualityChecker) SetSeverity(severity Severity) error {
+	parsed, err := ParseSeverity(string(severity))
+	if err != nil {
+		return err
+	}
+	c.severity = parsed
+	return nil
+}
+
+// LastResultParams returns the params logged by the most recent check, or nil if no check has run.
+// Callers such as the CLI use it to report details like error_count without re-running the check.
+func (c *DataQualityChecker) LastResultParams() map[string]interface{} {
+	return c.lastParams
+}
+
+// LastErrorCount returns the error_count logged by the most recent check, if it logged one
+func (c *DataQualityChecker) LastErrorCount() (int64, bool) {
+	switch n := c.lastParams["error_count"].(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	}
+	return 0, false
+}
+
+// quoteIdent wraps an identifier (column or table name) in double quotes, escaping embedded double quotes.
+// This keeps names like "order date" or "select" valid and prevents them from being parsed as SQL.
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// quoteLiteral wraps a value (e.g. a file path or pattern) in single quotes, escaping embedded single quotes
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// quoteLiterals quotes each value with quoteLiteral and joins them into the body of an IN list
+func quoteLiterals(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = quoteLiteral(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// foldCase returns the column expression and values a set-membership check compares. With ignoreCase, both sides
+// are lowercased, so that Active matches active; the column is cast to text first so numeric columns still work.
+func foldCase(column string, values []string, ignoreCase bool) (string, []string) {
+	if !ignoreCase {
+		return quoteIdent(column), values
+	}
+	lowered := make([]string, len(values))
+	for i, v := range values {
+		lowered[i] = strings.ToLower(v)
+	}
+	return fmt.Sprintf("lower(CAST(%s AS VARCHAR))", quoteIdent(column)), lowered
+}
+
+// isDelimitedTextFile reports whether the path looks like a CSV/TSV file (optionally compressed) based on its extension
+func isDelimitedTextFile(dataPath string) bool {
+	lower := strings.ToLower(dataPath)
+	lower = strings.TrimSuffix(strings.TrimSuffix(lower, ".gz"), ".zst")
+	return strings.HasSuffix(lower, ".csv") || strings.HasSuffix(lower, ".tsv") || strings.HasSuffix(lower, ".txt")
+}
+
+// isGlobPath reports whether dataPath is a glob pattern such as data/*.parquet, which os.Stat cannot resolve
+func isGlobPath(dataPath string) bool {
+	return strings.Contains(dataPath, "*")
+}
+
+// isDirectoryPath reports whether dataPath is a local directory, e.g. the root of a partitioned Parquet dataset
+func isDirectoryPath(dataPath string) bool {
+	info, err := os.Stat(dataPath)
+	return err == nil && info.IsDir()
+}
+
+// Supported values for ScanOptions.Format
+const (
+	formatCSV     = "csv"
+	formatJSON    = "json"
+	formatParquet = "parquet"
+)
+
+// ScanOptions controls how every check reads its data files.
+// The zero value detects the reader from the file extension and lets DuckDB sniff CSV dialects.
+type ScanOptions struct {
+	// Format forces the reader: csv, json (including newline-delimited JSON) or parquet
+	Format string
+	// Delimiter is the CSV field separator, e.g. ";" or "|"
+	Delimiter string
+	// NoHeader marks CSV files whose first line is data rather than column names
+	NoHeader bool
+	// NullString is the CSV token read as NULL, e.g. "NA"
+	NullString string
+	// Filter is a SQL predicate scoping each check to the matching rows of its data file, e.g. "region = 'EU'".
+	// Reference, baseline and compared files are read unfiltered. It is inserted verbatim, so it must be trusted.
+	Filter string
+	// HivePartitioning exposes the keys of Hive-style paths (year=2023/month=01/) as columns of Parquet reads.
+	// Without it, dataset reads ignore the partition keys and checks cannot see those columns.
+	HivePartitioning bool
+
+	// textColumns are CSV columns read as VARCHAR instead of their sniffed type (see scanRowsAsText)
+	textColumns []string
+}
+
+// hasCSVOptions reports whether any CSV-specific option is set
+func (o ScanOptions) hasCSVOptions() bool {
+	return o.Delimiter != "" || o.NoHeader || o.NullString != "" || len(o.textColumns) > 0
+}
+
+// ScanOptions returns the options set by SetScanOptions
+func (c *DataQualityChecker) ScanOptions() ScanOptions {
+	return c.scanOptions
+}
+
+// SetScanOptions sets how data files are read for every subsequent check.
+// Set a Format for ambiguous extensions such as .txt or .log, and the CSV options for files that
+// DuckDB's sniffer misreads, such as semicolon-delimited exports that would otherwise parse as one column.
+func (c *DataQualityChecker) SetScanOptions(opts ScanOptions) error {
+	if err := ValidateFormat(opts.Format); err != nil {
+		return err
+	}
+	c.scanOptions = opts
+	return nil
+}
+
+// ValidateFormat returns an error unless format is empty or one of the formats accepted by ScanOptions
+func ValidateFormat(format string) error {
+	switch format {
+	case "", formatCSV, formatJSON, formatParquet:
+		return nil
+	}
+	return fmt.Errorf("unsupported format %q: must be %s, %s or %s", format, formatCSV, formatJSON, formatParquet)
+}
+
+// isJSONFile reports whether the path looks like a JSON or newline-delimited JSON file (optionally compressed)
+func isJSONFile(dataPath string) bool {
+	lower := strings.ToLower(dataPath)
+	lower = strings.TrimSuffix(strings.TrimSuffix(lower, ".gz"), ".zst")
+	return strings.HasSuffix(lower, ".json") || strings.HasSuffix(lower, ".ndjson") || strings.HasSuffix(lower, ".jsonl")
+}
+
+// scanExpr returns the FROM-clause expression that reads dataPath with the given options.
+// JSON and NDJSON files go through read_json_auto, since a bare '.ndjson' or '.jsonl' path is not always
+// mapped to the JSON reader. CSV options turn any non-JSON, non-Parquet path into an explicit read_csv call,
+// e.g. read_csv('path', delim=';', header=false, nullstr='NA'). Otherwise DuckDB detects the reader itself.
+// A directory is read as a Parquet dataset through every .parquet file below it, and directories and
+// Parquet globs (data/*.parquet) use read_parquet so the matched files are read as one table. Dataset reads
+// set hive_partitioning explicitly from the HivePartitioning option, since DuckDB would otherwise auto-detect it.
+// Postgres and DuckDB table sources are read through tableScanExpr and ignore the file options.
+func scanExpr(dataPath string, opts ScanOptions) string {
+	if SourceTypeOf(dataPath) != SourceFile {
+		return tableScanExpr(dataPath)
+	}
+	dataset := isGlobPath(dataPath)
+	format := opts.Format
+	if isDirectoryPath(dataPath) {
+		dataPath = strings.TrimRight(dataPath, "/") + "/**/*.parquet"
+		dataset = true
+		format = formatParquet
+	}
+	if format == "" {
+		switch {
+		case (dataset || opts.HivePartitioning) && strings.HasSuffix(strings.ToLower(dataPath), ".parquet"):
+			format = formatParquet
+		case isJSONFile(dataPath):
+			format = formatJSON
+		case opts.hasCSVOptions() && !strings.HasSuffix(strings.ToLower(dataPath), ".parquet"):
+			format = formatCSV
+		}
+	}
+
+	switch format {
+	case formatCSV:
+		args := []string{quoteLiteral(dataPath)}
+		if opts.Delimiter != "" {
+			args = append(args, "delim="+quoteLiteral(opts.Delimiter))
+		}
+		if opts.NoHeader {
+			args = append(args, "header=false")
+		}
+		if opts.NullString != "" {
+			args = append(args, "nullstr="+quoteLiteral(opts.NullString))
+		}
+		if len(opts.textColumns) > 0 {
+			types := make([]string, len(opts.textColumns))
+			for i, col := range opts.textColumns {
+				types[i] = quoteLiteral(col) + ": 'VARCHAR'"
+			}
+			args = append(args, "types={"+strings.Join(types, ", ")+"}")
+		}
+		return fmt.Sprintf("read_csv(%s)", strings.Join(args, ", "))
+	case formatJSON:
+		return fmt.Sprintf("read_json_auto(%s)", quoteLiteral(dataPath))
+	case formatParquet:
+		if dataset || opts.HivePartitioning {
+			return fmt.Sprintf("read_parquet(%s, hive_partitioning=%t)", quoteLiteral(dataPath), opts.HivePartitioning)
+		}
+		return fmt.Sprintf("read_parquet(%s)", quoteLiteral(dataPath))
+	}
+	return quoteLiteral(dataPath)
+}
+
+// scan returns the FROM-clause expression for dataPath using the checker's scan options; every check reads through it
+func (c *DataQualityChecker) scan(dataPath string) string {
+	return scanExpr(dataPath, c.scanOptions)
+}
+
+// scanRows is scan restricted to the rows matching the Filter scan option, used for a check's own data file.
+// The filter is applied in a subquery, so it composes with each check's WHERE clause (including its
+// IS NOT NULL guards) without any operator-precedence surprises.
+func (c *DataQualityChecker) scanRows(dataPath string) string {
+	return filterRows(c.scan(dataPath), c.scanOptions.Filter)
+}
+
+// scanRowsAsText is scanRows with the given columns of a CSV file read as raw text rather than their sniffed type,
+// for checks that inspect how values are written: a sniffed TIMESTAMP or DOUBLE drops trailing zeros, for example.
+// Other formats keep their stored types.
+func (c *DataQualityChecker) scanRowsAsText(dataPath string, columns ...string) string {
+	opts := c.scanOptions
+	if opts.Format == formatCSV || (opts.Format == "" && isDelimitedTextFile(dataPath)) {
+		opts.textColumns = columns
+	}
+	return filterRows(scanExpr(dataPath, opts), opts.Filter)
+}
+
+// filterRows wraps a scan expression in a subquery keeping only the rows matching filter, if one is set
+func filterRows(scan, filter string) string {
+	if filter == "" {
+		return scan
+	}
+	return fmt.Sprintf("(SELECT * FROM %s WHERE (%s))", scan, filter)
+}
+
+// fetchSampleRows runs the given failing-rows query with a LIMIT and returns each row as a column name -> value map
+func fetchSampleRows(duckInfo *sql.DB, failingRowsQuery string, limit int) ([]map[string]interface{}, error) {
+	rows, err := duckInfo.Query(fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", failingRowsQuery, limit))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	var samples []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(cols))
+		dest := make([]interface{}, len(cols))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		sample := make(map[string]interface{}, len(cols))
+		for i, col := range cols {
+			sample[col] = values[i]
+		}
+		samples = append(samples, sample)
+	}
+	return samples, rows.Err()
+}
+
+// describeColumns returns the column names of a data file, in order, as reported by DESCRIBE
+func (c *DataQualityChecker) describeColumns(duckInfo *sql.DB, dataPath string) ([]string, error) {
+	query := fmt.Sprintf("SELECT column_name FROM (DESCRIBE SELECT * FROM %s)", c.scan(dataPath))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columnNames []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columnNames = append(columnNames, name)
+	}
+	return columnNames, rows.Err()
+}
+
+// validatePathExists checks if file exists and is readable by DuckDB.
+// Remote paths (s3://, https://, ...) cannot be stat'ed, so for them httpfs is loaded and the DuckDB read alone decides.
+// Globs are not stat'ed either: a pattern matching no files fails the DuckDB read instead.
+// Table sources are prepared with prepareTableSource, and a missing table likewise fails the read.
 func (c *DataQualityChecker) validatePathExists(dataPath string) error {
-	if _, err := os.Stat(dataPath); os.IsNotExist(err) {
-		return fmt.Errorf("data path not found: %s", dataPath)
+	source := SourceTypeOf(dataPath)
+	remote := isRemotePath(dataPath)
+	if source == SourceFile && !remote && !isGlobPath(dataPath) {
+		if _, err := os.Stat(dataPath); os.IsNotExist(err) {
+			return fmt.Errorf("data path not found: %s", dataPath)
+		}
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return fmt.Errorf("failed to open duckdb: %w", err)
+		return err
+	}
+	if remote {
+		if err := c.loadHTTPFS(duckInfo); err != nil {
+			return err
+		}
+	}
+	if source != SourceFile {
+		if err := c.prepareTableSource(duckInfo, dataPath); err != nil {
+			return err
+		}
 	}
-	defer duckInfo.Close()
 
 	// Check if DuckDB can parse header
 	// Use string formatting for TABLE path as it's not always supported as bind param in FROM clause in all drivers/contexts
-	query := fmt.Sprintf("SELECT * FROM '%s' LIMIT 0", dataPath)
+	query := fmt.Sprintf("SELECT * FROM %s LIMIT 0", c.scan(dataPath))
 	_, err = duckInfo.Exec(query)
 	if err != nil {
 		return fmt.Errorf("data path is not readable by DuckDB: %s. Error: %v", dataPath, err)
@@ -42,22 +538,65 @@ func (c *DataQualityChecker) validatePathExists(dataPath string) error {
 	return nil
 }
 
+// runToleranceCheck counts rows matching the violation predicate alongside the total row count in one query,
+// passes when the violating fraction is at most maxFailFraction, and logs both counts and the fraction.
+// Empty files have a fraction of 0 and pass, matching the strict checks.
+func (c *DataQualityChecker) runToleranceCheck(checkType, dataPath, violation string, maxFailFraction float64, params map[string]interface{}) (bool, error) {
+	start := time.Now()
+	if maxFailFraction < 0 || maxFailFraction > 1 {
+		return false, fmt.Errorf("max fail fraction must be within [0, 1], got %v", maxFailFraction)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(*) FILTER (WHERE %s), COUNT(*) FROM %s", violation, c.scanRows(dataPath))
+
+	var errorCount, totalCount int64
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &totalCount)
+	if err != nil {
+		return false, err
+	}
+
+	failFraction := 0.0
+	if totalCount > 0 {
+		failFraction = float64(errorCount) / float64(totalCount)
+	}
+	result := failFraction <= maxFailFraction
+
+	params["data_path"] = dataPath
+	params["error_count"] = errorCount
+	params["total_count"] = totalCount
+	params["fail_fraction"] = failFraction
+	params["max_fail_fraction"] = maxFailFraction
+	if err := c.logResult(checkType, result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
 // IsColumnUnique checks if the specified column in the data file contains unique values.
 // It returns true if all values are unique, false otherwise.
 func (c *DataQualityChecker) IsColumnUnique(dataPath, uniqueColumn string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	// SQL returns rows where duplicates exist (0 rows = success)
 	// Query: SELECT uniqueColumn FROM 'dataPath' GROUP BY uniqueColumn HAVING COUNT(*) > 1
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' GROUP BY %s HAVING COUNT(*) > 1", uniqueColumn, dataPath, uniqueColumn)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s GROUP BY %s HAVING COUNT(*) > 1", quoteIdent(uniqueColumn), c.scanRows(dataPath), quoteIdent(uniqueColumn))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -74,7 +613,53 @@ func (c *DataQualityChecker) IsColumnUnique(dataPath, uniqueColumn string) (bool
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_unique", result, params); err != nil {
+	if err := c.logResult("is_column_unique", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// AreColumnsUnique checks if the combination of the given columns is unique across all rows,
+// e.g. (order_id, line_number) where neither column is unique on its own.
+// It groups by all columns and counts groups with COUNT(*) > 1 (0 groups = success).
+func (c *DataQualityChecker) AreColumnsUnique(dataPath string, columns []string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if len(columns) == 0 {
+		return false, fmt.Errorf("at least one column is required")
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for i, col := range columns {
+		quotedColumns[i] = quoteIdent(col)
+	}
+	columnsStr := strings.Join(quotedColumns, ", ")
+
+	subQuery := fmt.Sprintf("SELECT %s FROM %s GROUP BY %s HAVING COUNT(*) > 1", columnsStr, c.scanRows(dataPath), columnsStr)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"columns":     columns,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("are_columns_unique", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -84,17 +669,17 @@ func (c *DataQualityChecker) IsColumnUnique(dataPath, uniqueColumn string) (bool
 // IsColumnNotNull checks if the specified column in the data file contains any null values.
 // It returns true if no null values are found, false otherwise.
 func (c *DataQualityChecker) IsColumnNotNull(dataPath, notNullColumn string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	subQuery := fmt.Sprintf("SELECT * FROM '%s' WHERE %s IS NULL", dataPath, notNullColumn)
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE %s IS NULL", c.scanRows(dataPath), quoteIdent(notNullColumn))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -110,52 +695,102 @@ func (c *DataQualityChecker) IsColumnNotNull(dataPath, notNullColumn string) (bo
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_not_null", result, params); err != nil {
+	if err := c.logResult("is_column_not_null", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
 	return result, nil
 }
 
+// IsColumnNotNullWithTolerance checks if the fraction of null values in a column is at most maxFailFraction.
+// Real pipelines often tolerate a small share of missing values (e.g. up to 1%) instead of failing on the first null.
+// It counts nulls and total rows in a single query and passes when errorCount / totalCount <= maxFailFraction.
+func (c *DataQualityChecker) IsColumnNotNullWithTolerance(dataPath, notNullColumn string, maxFailFraction float64) (bool, error) {
+	violation := fmt.Sprintf("%s IS NULL", quoteIdent(notNullColumn))
+	params := map[string]interface{}{
+		"column": notNullColumn,
+	}
+	return c.runToleranceCheck("is_column_not_null_with_tolerance", dataPath, violation, maxFailFraction, params)
+}
+
 // IsColumnEnum checks if the values in the specified column are within the allowed enum values.
 // It returns true if all values are valid, false otherwise.
 func (c *DataQualityChecker) IsColumnEnum(dataPath, enumColumn string, enumValues []string) (bool, error) {
+	return c.IsColumnEnumIgnoreCase(dataPath, enumColumn, enumValues, false)
+}
+
+// IsColumnEnumIgnoreCase is IsColumnEnum with the option to compare case-insensitively
+func (c *DataQualityChecker) IsColumnEnumIgnoreCase(dataPath, enumColumn string, enumValues []string, ignoreCase bool) (bool, error) {
+	detailed, err := c.IsColumnEnumDetailedIgnoreCase(dataPath, enumColumn, enumValues, ignoreCase)
+	return detailed.Passed, err
+}
+
+// IsColumnEnumDetailed checks the column against the allowed values like IsColumnEnum, and on failure also returns
+// a histogram of the invalid values: each value with the number of rows holding it, most frequent first.
+// That tells a one-off typo from a systemic problem at a glance. The histogram groups the same subquery used for
+// counting, is capped at maxLoggedOffenders values, and is persisted to the log as "offenders".
+func (c *DataQualityChecker) IsColumnEnumDetailed(dataPath, enumColumn string, enumValues []string) (CheckResult, error) {
+	return c.IsColumnEnumDetailedIgnoreCase(dataPath, enumColumn, enumValues, false)
+}
+
+// IsColumnEnumDetailedIgnoreCase is IsColumnEnumDetailed with the option to compare case-insensitively.
+// The histogram still reports the invalid values as written.
+func (c *DataQualityChecker) IsColumnEnumDetailedIgnoreCase(dataPath, enumColumn string, enumValues []string, ignoreCase bool) (CheckResult, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
-		return false, err
+		return CheckResult{}, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return CheckResult{}, err
 	}
-	defer duckInfo.Close()
 
-	// Format enum values as 'v1', 'v2'
-	quotedValues := make([]string, len(enumValues))
-	for i, v := range enumValues {
-		quotedValues[i] = fmt.Sprintf("'%s'", v)
-	}
-	enumValsStr := strings.Join(quotedValues, ", ")
+	compared, comparedValues := foldCase(enumColumn, enumValues, ignoreCase)
+	enumValsStr := quoteLiterals(comparedValues)
 
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE %s NOT IN (%s) AND %s IS NOT NULL",
-		enumColumn, dataPath, enumColumn, enumValsStr, enumColumn)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s NOT IN (%s) AND %s IS NOT NULL",
+		quoteIdent(enumColumn), c.scanRows(dataPath), compared, enumValsStr, quoteIdent(enumColumn))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
 	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
 	if err != nil {
-		return false, err
+		return CheckResult{}, err
 	}
 
-	result := errorCount == 0
+	result := CheckResult{Passed: errorCount == 0, ErrorCount: errorCount}
+	if errorCount > 0 {
+		histogramQuery := fmt.Sprintf("SELECT CAST(%s AS VARCHAR) AS value, COUNT(*) AS n FROM (%s) GROUP BY value ORDER BY n DESC, value LIMIT %d",
+			quoteIdent(enumColumn), subQuery, maxLoggedOffenders)
+		rows, err := duckInfo.Query(histogramQuery)
+		if err != nil {
+			return result, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var offender ValueCount
+			if err := rows.Scan(&offender.Value, &offender.Count); err != nil {
+				return result, err
+			}
+			result.Offenders = append(result.Offenders, offender)
+		}
+		if err := rows.Err(); err != nil {
+			return result, err
+		}
+	}
 
 	params := map[string]interface{}{
 		"column":      enumColumn,
 		"enum_values": enumValues,
+		"ignore_case": ignoreCase,
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_enum", result, params); err != nil {
+	if len(result.Offenders) > 0 {
+		params["offenders"] = result.Offenders
+	}
+	if err := c.logResult("is_column_enum", result.Passed, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -165,6 +800,17 @@ func (c *DataQualityChecker) IsColumnEnum(dataPath, enumColumn string, enumValue
 // AreTablesReferentialIntegral checks if the foreign key relationships between two tables are valid.
 // It ensures that values in the joining columns of the data file exist in the reference file.
 func (c *DataQualityChecker) AreTablesReferentialIntegral(dataPath, referencePath string, joinKeys []string) (bool, error) {
+	return c.AreTablesReferentialIntegralEmptyAsNull(dataPath, referencePath, joinKeys, false)
+}
+
+// AreTablesReferentialIntegralEmptyAsNull is AreTablesReferentialIntegral with the option to treat empty-string
+// keys as missing. Exports often write a missing foreign key as an empty string rather than NULL, and a plain join
+// matches it against an empty key in the reference or, without one, reports it as an orphan. DuckDB's CSV reader
+// already reads empty fields as NULL, but JSON and Parquet files (or CSVs read with a NullString) keep them as
+// empty strings. With treatEmptyAsNull, keys whose text is empty are coerced to NULL on both sides and the data
+// rows holding them are excluded, so a missing key is neither matched nor counted as an orphan.
+func (c *DataQualityChecker) AreTablesReferentialIntegralEmptyAsNull(dataPath, referencePath string, joinKeys []string, treatEmptyAsNull bool) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
@@ -172,18 +818,27 @@ func (c *DataQualityChecker) AreTablesReferentialIntegral(dataPath, referencePat
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	var joinConditionsParts []string
 	var whereConditionsParts []string
 
+	keyExpr := func(side, key string) string {
+		column := side + "." + quoteIdent(key)
+		if !treatEmptyAsNull {
+			return column
+		}
+		return fmt.Sprintf("(CASE WHEN CAST(%s AS VARCHAR) = '' THEN NULL ELSE %s END)", column, column)
+	}
 	for _, key := range joinKeys {
-		joinConditionsParts = append(joinConditionsParts, fmt.Sprintf("l.%s = r.%s", key, key))
-		whereConditionsParts = append(whereConditionsParts, fmt.Sprintf("r.%s IS NULL", key))
+		joinConditionsParts = append(joinConditionsParts, fmt.Sprintf("%s = %s", keyExpr("l", key), keyExpr("r", key)))
+		whereConditionsParts = append(whereConditionsParts, fmt.Sprintf("r.%s IS NULL", quoteIdent(key)))
+		if treatEmptyAsNull {
+			whereConditionsParts = append(whereConditionsParts, fmt.Sprintf("%s IS NOT NULL", keyExpr("l", key)))
+		}
 	}
 
 	joinConditions := strings.Join(joinConditionsParts, " AND ")
@@ -191,10 +846,10 @@ func (c *DataQualityChecker) AreTablesReferentialIntegral(dataPath, referencePat
 
 	subQuery := fmt.Sprintf(`
 		SELECT l.* 
-		FROM '%s' l 
-		LEFT JOIN '%s' r ON %s 
+		FROM %s l 
+		LEFT JOIN %s r ON %s 
 		WHERE %s
-	`, dataPath, referencePath, joinConditions, whereConditions)
+	`, c.scanRows(dataPath), c.scan(referencePath), joinConditions, whereConditions)
 
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
@@ -208,11 +863,12 @@ func (c *DataQualityChecker) AreTablesReferentialIntegral(dataPath, referencePat
 
 	params := map[string]interface{}{
 		"join_keys":      joinKeys,
+		"empty_as_null":  treatEmptyAsNull,
 		"data_path":      dataPath,
 		"reference_path": referencePath,
 		"error_count":    errorCount,
 	}
-	if err := c.dbConnector.Log("are_tables_referential_integral", result, params); err != nil {
+	if err := c.logResult("are_tables_referential_integral", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -222,6 +878,7 @@ func (c *DataQualityChecker) AreTablesReferentialIntegral(dataPath, referencePat
 // IsColumnInData checks if the specified column exists in the data file.
 // It returns true if the column exists, false otherwise.
 func (c *DataQualityChecker) IsColumnInData(dataPath, columnName string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		// Log failure as well? Python code didn't exist validation for this specific logic explicitly before call inside IsColumnInData,
 		// but `is_column_in_data` in python:
@@ -240,13 +897,12 @@ func (c *DataQualityChecker) IsColumnInData(dataPath, columnName string) (bool,
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT %s FROM '%s' LIMIT 0", columnName, dataPath)
+	query := fmt.Sprintf("SELECT %s FROM %s LIMIT 0", quoteIdent(columnName), c.scanRows(dataPath))
 	_, err = duckInfo.Exec(query)
 	result := err == nil
 
@@ -254,7 +910,7 @@ func (c *DataQualityChecker) IsColumnInData(dataPath, columnName string) (bool,
 		"column":    columnName,
 		"data_path": dataPath,
 	}
-	if err := c.dbConnector.Log("is_column_in_data", result, params); err != nil {
+	if err := c.logResult("is_column_in_data", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -263,26 +919,40 @@ func (c *DataQualityChecker) IsColumnInData(dataPath, columnName string) (bool,
 
 // IsColumnBetween checks if the values in a column are within a numeric range [min, max].
 func (c *DataQualityChecker) IsColumnBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	detailed, err := c.IsColumnBetweenDetailed(dataPath, columnName, min, max)
+	return detailed.Passed, err
+}
+
+// IsColumnBetweenDetailed checks if the values in a column are within [min, max] like IsColumnBetween,
+// but also returns the error count and up to maxSampleRows failing rows so bad data can be inspected directly.
+// The samples come from the same subquery used for counting, limited, and are persisted to the log.
+func (c *DataQualityChecker) IsColumnBetweenDetailed(dataPath, columnName string, min, max float64) (CheckResult, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
-		return false, err
+		return CheckResult{}, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return CheckResult{}, err
 	}
-	defer duckInfo.Close()
 
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE %s < %f OR %s > %f", columnName, dataPath, columnName, min, columnName, max)
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE %s < %f OR %s > %f", c.scanRows(dataPath), quoteIdent(columnName), min, quoteIdent(columnName), max)
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
 	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
 	if err != nil {
-		return false, err
+		return CheckResult{}, err
 	}
 
-	result := errorCount == 0
+	result := CheckResult{Passed: errorCount == 0, ErrorCount: errorCount}
+	if errorCount > 0 {
+		result.Samples, err = fetchSampleRows(duckInfo, subQuery, maxSampleRows)
+		if err != nil {
+			return result, err
+		}
+	}
 
 	params := map[string]interface{}{
 		"column":      columnName,
@@ -291,28 +961,43 @@ func (c *DataQualityChecker) IsColumnBetween(dataPath, columnName string, min, m
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_between", result, params); err != nil {
+	if len(result.Samples) > 0 {
+		params["samples"] = result.Samples
+	}
+	if err := c.logResult("is_column_between", result.Passed, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
 	return result, nil
 }
 
+// IsColumnBetweenWithTolerance checks if the fraction of values outside [min, max] is at most maxFailFraction.
+// Like IsColumnNotNullWithTolerance, it counts violations and total rows in a single query.
+func (c *DataQualityChecker) IsColumnBetweenWithTolerance(dataPath, columnName string, min, max, maxFailFraction float64) (bool, error) {
+	violation := fmt.Sprintf("%s < %f OR %s > %f", quoteIdent(columnName), min, quoteIdent(columnName), max)
+	params := map[string]interface{}{
+		"column": columnName,
+		"min":    min,
+		"max":    max,
+	}
+	return c.runToleranceCheck("is_column_between_with_tolerance", dataPath, violation, maxFailFraction, params)
+}
+
 // IsColumnRegexMatch checks if string values in a column match a given RE2 regular expression.
 func (c *DataQualityChecker) IsColumnRegexMatch(dataPath, columnName, regex string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	// DuckDB uses regexp_matches(column, pattern) or column ~ pattern
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE NOT (regexp_matches(%s, '%s')) AND %s IS NOT NULL",
-		columnName, dataPath, columnName, regex, columnName)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(%s, %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(regex), quoteIdent(columnName))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -329,7 +1014,7 @@ func (c *DataQualityChecker) IsColumnRegexMatch(dataPath, columnName, regex stri
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_regex_match", result, params); err != nil {
+	if err := c.logResult("is_column_regex_match", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -338,19 +1023,19 @@ func (c *DataQualityChecker) IsColumnRegexMatch(dataPath, columnName, regex stri
 
 // IsColumnOfType checks if the values in a column can be cast to the specified DuckDB type.
 func (c *DataQualityChecker) IsColumnOfType(dataPath, columnName, targetType string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	// Try to cast and see if any nulls are produced where original wasn't null
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE TRY_CAST(%s AS %s) IS NULL AND %s IS NOT NULL",
-		columnName, dataPath, columnName, targetType, columnName)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE TRY_CAST(%s AS %s) IS NULL AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), targetType, quoteIdent(columnName))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -367,7 +1052,67 @@ func (c *DataQualityChecker) IsColumnOfType(dataPath, columnName, targetType str
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_of_type", result, params); err != nil {
+	if err := c.logResult("is_column_of_type", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// numericTypePrefixes lists the DuckDB type names (or name prefixes, for parameterized types) treated as numeric
+var numericTypePrefixes = []string{
+	"TINYINT", "SMALLINT", "INTEGER", "BIGINT", "HUGEINT",
+	"UTINYINT", "USMALLINT", "UINTEGER", "UBIGINT", "UHUGEINT",
+	"FLOAT", "DOUBLE", "DECIMAL",
+}
+
+// isNumericType reports whether a DESCRIBE column_type is a numeric DuckDB type
+func isNumericType(columnType string) bool {
+	upper := strings.ToUpper(columnType)
+	for _, prefix := range numericTypePrefixes {
+		if upper == prefix || strings.HasPrefix(upper, prefix+"(") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsColumnNumericTyped checks if a column's inferred storage type is numeric.
+// A single stray value can make DuckDB infer VARCHAR for a meant-to-be-numeric column, breaking downstream math
+// even when every other value looks like a number. Unlike IsColumnOfType, it inspects the DESCRIBE type rather
+// than which values cast, so a VARCHAR column fails even if all its values happen to be numeric.
+// The inferred type is logged.
+func (c *DataQualityChecker) IsColumnNumericTyped(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT column_type FROM (DESCRIBE SELECT * FROM %s) WHERE column_name = %s",
+		c.scanRows(dataPath), quoteLiteral(columnName))
+
+	var columnType string
+	err = duckInfo.QueryRow(query).Scan(&columnType)
+	if err == sql.ErrNoRows {
+		return false, fmt.Errorf("column %q not found in %s", columnName, dataPath)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	result := isNumericType(columnType)
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"data_path":     dataPath,
+		"inferred_type": columnType,
+	}
+	if err := c.logResult("is_column_numeric_typed", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -376,18 +1121,18 @@ func (c *DataQualityChecker) IsColumnOfType(dataPath, columnName, targetType str
 
 // IsColumnLengthBetween checks if the length of string or object values in a column is within [min, max].
 func (c *DataQualityChecker) IsColumnLengthBetween(dataPath, columnName string, min, max int) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE length(%s) < %d OR length(%s) > %d",
-		columnName, dataPath, columnName, min, columnName, max)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE length(%s) < %d OR length(%s) > %d",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), min, quoteIdent(columnName), max)
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -405,7 +1150,7 @@ func (c *DataQualityChecker) IsColumnLengthBetween(dataPath, columnName string,
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_length_between", result, params); err != nil {
+	if err := c.logResult("is_column_length_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -414,17 +1159,17 @@ func (c *DataQualityChecker) IsColumnLengthBetween(dataPath, columnName string,
 
 // IsColumnMaxBetween checks if the maximum value in a column is within [min, max].
 func (c *DataQualityChecker) IsColumnMaxBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT MAX(%s) FROM '%s'", columnName, dataPath)
+	query := fmt.Sprintf("SELECT MAX(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
 
 	var maxValue float64
 	err = duckInfo.QueryRow(query).Scan(&maxValue)
@@ -441,7 +1186,7 @@ func (c *DataQualityChecker) IsColumnMaxBetween(dataPath, columnName string, min
 		"max_allowed": max,
 		"data_path":   dataPath,
 	}
-	if err := c.dbConnector.Log("is_column_max_between", result, params); err != nil {
+	if err := c.logResult("is_column_max_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -450,17 +1195,17 @@ func (c *DataQualityChecker) IsColumnMaxBetween(dataPath, columnName string, min
 
 // IsColumnMinBetween checks if the minimum value in a column is within [min, max].
 func (c *DataQualityChecker) IsColumnMinBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT MIN(%s) FROM '%s'", columnName, dataPath)
+	query := fmt.Sprintf("SELECT MIN(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
 
 	var minValue float64
 	err = duckInfo.QueryRow(query).Scan(&minValue)
@@ -477,7 +1222,7 @@ func (c *DataQualityChecker) IsColumnMinBetween(dataPath, columnName string, min
 		"max_allowed": max,
 		"data_path":   dataPath,
 	}
-	if err := c.dbConnector.Log("is_column_min_between", result, params); err != nil {
+	if err := c.logResult("is_column_min_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -486,17 +1231,17 @@ func (c *DataQualityChecker) IsColumnMinBetween(dataPath, columnName string, min
 
 // IsColumnMeanBetween checks if the mean value in a column is within [min, max].
 func (c *DataQualityChecker) IsColumnMeanBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT AVG(%s) FROM '%s'", columnName, dataPath)
+	query := fmt.Sprintf("SELECT AVG(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
 
 	var avgValue float64
 	err = duckInfo.QueryRow(query).Scan(&avgValue)
@@ -513,7 +1258,7 @@ func (c *DataQualityChecker) IsColumnMeanBetween(dataPath, columnName string, mi
 		"max_allowed": max,
 		"data_path":   dataPath,
 	}
-	if err := c.dbConnector.Log("is_column_mean_between", result, params); err != nil {
+	if err := c.logResult("is_column_mean_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -522,17 +1267,17 @@ func (c *DataQualityChecker) IsColumnMeanBetween(dataPath, columnName string, mi
 
 // IsColumnMedianBetween checks if the median value in a column is within [min, max].
 func (c *DataQualityChecker) IsColumnMedianBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT MEDIAN(%s) FROM '%s'", columnName, dataPath)
+	query := fmt.Sprintf("SELECT MEDIAN(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
 
 	var medianValue float64
 	err = duckInfo.QueryRow(query).Scan(&medianValue)
@@ -549,45 +1294,210 @@ func (c *DataQualityChecker) IsColumnMedianBetween(dataPath, columnName string,
 		"max_allowed":  max,
 		"data_path":    dataPath,
 	}
-	if err := c.dbConnector.Log("is_column_median_between", result, params); err != nil {
+	if err := c.logResult("is_column_median_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
 	return result, nil
 }
 
-// IsColumnDateFormat checks if string values in a column match a given strftime date format.
-func (c *DataQualityChecker) IsColumnDateFormat(dataPath, columnName, format string) (bool, error) {
+// IsColumnSumBetween checks if the sum of a column is within [min, max].
+func (c *DataQualityChecker) IsColumnSumBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	// DuckDB strptime returns NULL if format doesn't match. Cast to VARCHAR to ensure it works even if auto-detected as DATE.
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE strptime(CAST(%s AS VARCHAR), '%s') IS NULL AND %s IS NOT NULL",
-		columnName, dataPath, columnName, format, columnName)
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+	query := fmt.Sprintf("SELECT SUM(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
 
-	var errorCount int64
-	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	var sumValue float64
+	err = duckInfo.QueryRow(query).Scan(&sumValue)
 	if err != nil {
 		return false, err
 	}
 
-	result := errorCount == 0
+	result := sumValue >= min && sumValue <= max
 
 	params := map[string]interface{}{
 		"column":      columnName,
-		"format":      format,
+		"sum_value":   sumValue,
+		"min_allowed": min,
+		"max_allowed": max,
+		"data_path":   dataPath,
+	}
+	if err := c.logResult("is_column_sum_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnStdDevBetween checks if the sample standard deviation of a column is within [min, max].
+// A collapsed (near-constant) or exploding spread often means a bad batch even when min/max look normal.
+// It uses DuckDB's stddev_samp, which is NULL for fewer than two non-null values, so that case returns an error.
+func (c *DataQualityChecker) IsColumnStdDevBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT stddev_samp(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
+
+	var stddevValue sql.NullFloat64
+	err = duckInfo.QueryRow(query).Scan(&stddevValue)
+	if err != nil {
+		return false, err
+	}
+	if !stddevValue.Valid {
+		return false, fmt.Errorf("standard deviation of column '%s' is undefined: at least two non-null values are required", columnName)
+	}
+
+	result := stddevValue.Float64 >= min && stddevValue.Float64 <= max
+
+	params := map[string]interface{}{
+		"column":       columnName,
+		"stddev_value": stddevValue.Float64,
+		"min_allowed":  min,
+		"max_allowed":  max,
+		"data_path":    dataPath,
+	}
+	if err := c.logResult("is_column_stddev_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// AvgWordCountBetween checks if the average number of whitespace-separated words per non-null value is within [min, max].
+// Content pipelines watch text richness, and a sudden shift to very short or very long entries signals a bad feed.
+// It splits each trimmed value on runs of whitespace with regexp_split_to_array, counting blank values as zero words,
+// and averages the counts with avg. A column with no non-null values returns an error.
+func (c *DataQualityChecker) AvgWordCountBetween(dataPath, columnName string, min, max float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT avg(CASE WHEN trim(CAST(%s AS VARCHAR)) = '' THEN 0
+			ELSE len(regexp_split_to_array(trim(CAST(%s AS VARCHAR)), '\s+')) END)
+		FROM %s WHERE %s IS NOT NULL
+	`, quoteIdent(columnName), quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+
+	var avgWordCount sql.NullFloat64
+	err = duckInfo.QueryRow(query).Scan(&avgWordCount)
+	if err != nil {
+		return false, err
+	}
+	if !avgWordCount.Valid {
+		return false, fmt.Errorf("average word count of column '%s' is undefined: it has no non-null values", columnName)
+	}
+
+	result := avgWordCount.Float64 >= min && avgWordCount.Float64 <= max
+
+	params := map[string]interface{}{
+		"column":         columnName,
+		"avg_word_count": avgWordCount.Float64,
+		"min_allowed":    min,
+		"max_allowed":    max,
+		"data_path":      dataPath,
+	}
+	if err := c.logResult("avg_word_count_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnQuantileBetween checks if the given quantile of a column is within [min, max].
+// This lets users assert tail behaviour, e.g. that the 95th percentile latency stays under a threshold.
+// It uses DuckDB's quantile_cont, interpolating between values; quantile must be within [0, 1].
+func (c *DataQualityChecker) IsColumnQuantileBetween(dataPath, columnName string, quantile, min, max float64) (bool, error) {
+	start := time.Now()
+	if quantile < 0 || quantile > 1 {
+		return false, fmt.Errorf("quantile must be within [0, 1], got %v", quantile)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT quantile_cont(%s, %f) FROM %s", quoteIdent(columnName), quantile, c.scanRows(dataPath))
+
+	var quantileValue float64
+	err = duckInfo.QueryRow(query).Scan(&quantileValue)
+	if err != nil {
+		return false, err
+	}
+
+	result := quantileValue >= min && quantileValue <= max
+
+	params := map[string]interface{}{
+		"column":         columnName,
+		"quantile":       quantile,
+		"quantile_value": quantileValue,
+		"min_allowed":    min,
+		"max_allowed":    max,
+		"data_path":      dataPath,
+	}
+	if err := c.logResult("is_column_quantile_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnDateFormat checks if string values in a column match a given strftime date format.
+func (c *DataQualityChecker) IsColumnDateFormat(dataPath, columnName, format string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	// DuckDB strptime returns NULL if format doesn't match. Cast to VARCHAR to ensure it works even if auto-detected as DATE.
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE strptime(CAST(%s AS VARCHAR), %s) IS NULL AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(format), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"format":      format,
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_date_format", result, params); err != nil {
+	if err := c.logResult("is_column_date_format", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -596,17 +1506,17 @@ func (c *DataQualityChecker) IsColumnDateFormat(dataPath, columnName, format str
 
 // IsTableRowCountBetween checks if the total number of rows in the table is within [min, max].
 func (c *DataQualityChecker) IsTableRowCountBetween(dataPath string, min, max int64) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	query := fmt.Sprintf("SELECT COUNT(*) FROM '%s'", dataPath)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", c.scanRows(dataPath))
 
 	var rowCount int64
 	err = duckInfo.QueryRow(query).Scan(&rowCount)
@@ -622,7 +1532,44 @@ func (c *DataQualityChecker) IsTableRowCountBetween(dataPath string, min, max in
 		"max_allowed": max,
 		"data_path":   dataPath,
 	}
-	if err := c.dbConnector.Log("is_table_row_count_between", result, params); err != nil {
+	if err := c.logResult("is_table_row_count_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnDistinctCountBetween checks if the number of distinct non-null values in a column is within [min, max],
+// e.g. a country column should hold between 1 and 200 distinct values.
+func (c *DataQualityChecker) IsColumnDistinctCountBetween(dataPath, columnName string, min, max int64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(DISTINCT %s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
+
+	var distinctCount int64
+	err = duckInfo.QueryRow(query).Scan(&distinctCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := distinctCount >= min && distinctCount <= max
+
+	params := map[string]interface{}{
+		"column":         columnName,
+		"distinct_count": distinctCount,
+		"min_allowed":    min,
+		"max_allowed":    max,
+		"data_path":      dataPath,
+	}
+	if err := c.logResult("is_column_distinct_count_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -631,19 +1578,19 @@ func (c *DataQualityChecker) IsTableRowCountBetween(dataPath string, min, max in
 
 // IsTableColumnCountBetween checks if the number of columns in the table is within [min, max].
 func (c *DataQualityChecker) IsTableColumnCountBetween(dataPath string, min, max int) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	// DuckDB system view for columns
 	// We need to be careful about table names. DuckDB treats file paths as table names in some contexts.
-	query := fmt.Sprintf("SELECT COUNT(*) FROM (DESCRIBE SELECT * FROM '%s')", dataPath)
+	query := fmt.Sprintf("SELECT COUNT(*) FROM (DESCRIBE SELECT * FROM %s)", c.scan(dataPath))
 
 	var colCount int
 	err = duckInfo.QueryRow(query).Scan(&colCount)
@@ -659,7 +1606,7 @@ func (c *DataQualityChecker) IsTableColumnCountBetween(dataPath string, min, max
 		"max_allowed": max,
 		"data_path":   dataPath,
 	}
-	if err := c.dbConnector.Log("is_table_column_count_between", result, params); err != nil {
+	if err := c.logResult("is_table_column_count_between", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -668,24 +1615,26 @@ func (c *DataQualityChecker) IsTableColumnCountBetween(dataPath string, min, max
 
 // IsColumnNotInSet checks if values in a column are NOT present in a given "blacklisted" set.
 func (c *DataQualityChecker) IsColumnNotInSet(dataPath, columnName string, blacklistedValues []string) (bool, error) {
+	return c.IsColumnNotInSetIgnoreCase(dataPath, columnName, blacklistedValues, false)
+}
+
+// IsColumnNotInSetIgnoreCase is IsColumnNotInSet with the option to compare case-insensitively
+func (c *DataQualityChecker) IsColumnNotInSetIgnoreCase(dataPath, columnName string, blacklistedValues []string, ignoreCase bool) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	quotedValues := make([]string, len(blacklistedValues))
-	for i, v := range blacklistedValues {
-		quotedValues[i] = fmt.Sprintf("'%s'", v)
-	}
-	blackListStr := strings.Join(quotedValues, ", ")
+	compared, comparedValues := foldCase(columnName, blacklistedValues, ignoreCase)
+	blackListStr := quoteLiterals(comparedValues)
 
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE %s IN (%s)",
-		columnName, dataPath, columnName, blackListStr)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%s)",
+		quoteIdent(columnName), c.scanRows(dataPath), compared, blackListStr)
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -699,10 +1648,11 @@ func (c *DataQualityChecker) IsColumnNotInSet(dataPath, columnName string, black
 	params := map[string]interface{}{
 		"column":      columnName,
 		"blacklist":   blacklistedValues,
+		"ignore_case": ignoreCase,
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_not_in_set", result, params); err != nil {
+	if err := c.logResult("is_column_not_in_set", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -710,24 +1660,74 @@ func (c *DataQualityChecker) IsColumnNotInSet(dataPath, columnName string, black
 }
 
 // IsColumnIncreasing checks if the values in a column are in strictly ascending order.
+// Rows are compared in file order, numbered deterministically with row_number(); use IsColumnIncreasingBy
+// when the file's physical order is not meaningful.
 func (c *DataQualityChecker) IsColumnIncreasing(dataPath, columnName string) (bool, error) {
+	return c.IsColumnIncreasingBy(dataPath, columnName, "")
+}
+
+// IsColumnIncreasingBy is IsColumnIncreasing with rows compared in orderBy order (e.g. a timestamp or id column).
+// It is IsColumnMonotonic with direction "increasing" and strict set.
+func (c *DataQualityChecker) IsColumnIncreasingBy(dataPath, columnName, orderBy string) (bool, error) {
+	return c.IsColumnMonotonic(dataPath, columnName, "increasing", true, orderBy)
+}
+
+// IsColumnDecreasing checks if the values in a column are in strictly descending order, e.g. a countdown.
+// Like IsColumnIncreasing, rows are compared in file order.
+func (c *DataQualityChecker) IsColumnDecreasing(dataPath, columnName string) (bool, error) {
+	return c.IsColumnDecreasingBy(dataPath, columnName, "")
+}
+
+// IsColumnDecreasingBy is IsColumnDecreasing with rows compared in orderBy order.
+// It is IsColumnMonotonic with direction "decreasing" and strict set.
+func (c *DataQualityChecker) IsColumnDecreasingBy(dataPath, columnName, orderBy string) (bool, error) {
+	return c.IsColumnMonotonic(dataPath, columnName, "decreasing", true, orderBy)
+}
+
+// IsColumnMonotonic checks if the values in a column only move in one direction ("increasing" or "decreasing").
+// With strict set consecutive equal values fail; otherwise they are allowed (non-decreasing / non-increasing),
+// which suits running balances that may stay flat.
+// Rows are compared in orderBy order (e.g. a timestamp or id column) when orderBy is set. Otherwise file order is
+// used: rows are numbered with row_number() as they are read, so the comparison is deterministic within a single
+// read, but callers should pass orderBy whenever the file's physical order is not meaningful.
+func (c *DataQualityChecker) IsColumnMonotonic(dataPath, columnName, direction string, strict bool, orderBy string) (bool, error) {
+	start := time.Now()
+	// Each direction maps to the comparison against the previous value that marks a violation
+	var violation string
+	switch direction {
+	case "increasing":
+		violation = "<"
+	case "decreasing":
+		violation = ">"
+	default:
+		return false, fmt.Errorf("unsupported direction %q: must be increasing or decreasing", direction)
+	}
+	if strict {
+		violation += "="
+	}
+
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
+	}
+
+	// Number rows in read order first, so ties in orderBy (or no orderBy at all) still have a fixed sequence
+	windowOrder := "file_row"
+	if orderBy != "" {
+		windowOrder = quoteIdent(orderBy) + ", file_row"
 	}
-	defer duckInfo.Close()
 
 	// Use window function LAG to compare with previous row
 	subQuery := fmt.Sprintf(`
-		SELECT %s, LAG(%s) OVER () as prev_val 
-		FROM '%s'
-	`, columnName, columnName, dataPath)
+		SELECT %s, LAG(%s) OVER (ORDER BY %s) as prev_val
+		FROM (SELECT *, row_number() OVER () AS file_row FROM %s)
+	`, quoteIdent(columnName), quoteIdent(columnName), windowOrder, c.scanRows(dataPath))
 
-	errorQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) WHERE %s <= prev_val", subQuery, columnName)
+	errorQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) WHERE %s %s prev_val", subQuery, quoteIdent(columnName), violation)
 
 	var errorCount int64
 	err = duckInfo.QueryRow(errorQuery).Scan(&errorCount)
@@ -739,10 +1739,12 @@ func (c *DataQualityChecker) IsColumnIncreasing(dataPath, columnName string) (bo
 
 	params := map[string]interface{}{
 		"column":      columnName,
+		"strict":      strict,
+		"order_by":    orderBy,
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_increasing", result, params); err != nil {
+	if err := c.logResult("is_column_"+direction, result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -751,19 +1753,19 @@ func (c *DataQualityChecker) IsColumnIncreasing(dataPath, columnName string) (bo
 
 // IsColumnDateParseable checks if values in a column can be parsed as dates by DuckDB.
 func (c *DataQualityChecker) IsColumnDateParseable(dataPath, columnName string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
 	// TRY_CAST to DATE returns NULL if parsing fails
-	subQuery := fmt.Sprintf("SELECT %s FROM '%s' WHERE TRY_CAST(%s AS DATE) IS NULL AND %s IS NOT NULL",
-		columnName, dataPath, columnName, columnName)
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE TRY_CAST(%s AS DATE) IS NULL AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteIdent(columnName))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -779,7 +1781,7 @@ func (c *DataQualityChecker) IsColumnDateParseable(dataPath, columnName string)
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("is_column_date_parseable", result, params); err != nil {
+	if err := c.logResult("is_column_date_parseable", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -788,18 +1790,19 @@ func (c *DataQualityChecker) IsColumnDateParseable(dataPath, columnName string)
 
 // AreColumnPairsEqual checks if the values in two columns are equal for every row.
 func (c *DataQualityChecker) AreColumnPairsEqual(dataPath, col1, col2 string) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	subQuery := fmt.Sprintf("SELECT %s, %s FROM '%s' WHERE %s != %s OR (%s IS NULL AND %s IS NOT NULL) OR (%s IS NOT NULL AND %s IS NULL)",
-		col1, col2, dataPath, col1, col2, col1, col2, col1, col2)
+	c1, c2 := quoteIdent(col1), quoteIdent(col2)
+	subQuery := fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s != %s OR (%s IS NULL AND %s IS NOT NULL) OR (%s IS NOT NULL AND %s IS NULL)",
+		c1, c2, c.scanRows(dataPath), c1, c2, c1, c2, c1, c2)
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -816,7 +1819,7 @@ func (c *DataQualityChecker) AreColumnPairsEqual(dataPath, col1, col2 string) (b
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("are_column_pairs_equal", result, params); err != nil {
+	if err := c.logResult("are_column_pairs_equal", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -825,24 +1828,27 @@ func (c *DataQualityChecker) AreColumnPairsEqual(dataPath, col1, col2 string) (b
 
 // AreDistinctValuesInSet checks if all unique values in a column are within a predefined list.
 func (c *DataQualityChecker) AreDistinctValuesInSet(dataPath, columnName string, allowedValues []string) (bool, error) {
+	return c.AreDistinctValuesInSetIgnoreCase(dataPath, columnName, allowedValues, false)
+}
+
+// AreDistinctValuesInSetIgnoreCase is AreDistinctValuesInSet with the option to compare case-insensitively.
+// Values differing only in case are then counted once.
+func (c *DataQualityChecker) AreDistinctValuesInSetIgnoreCase(dataPath, columnName string, allowedValues []string, ignoreCase bool) (bool, error) {
+	start := time.Now()
 	if err := c.validatePathExists(dataPath); err != nil {
 		return false, err
 	}
 
-	duckInfo, err := sql.Open("duckdb", "")
+	duckInfo, err := c.getDuckDB()
 	if err != nil {
-		return false, fmt.Errorf("failed to open duckdb: %w", err)
+		return false, err
 	}
-	defer duckInfo.Close()
 
-	quotedValues := make([]string, len(allowedValues))
-	for i, v := range allowedValues {
-		quotedValues[i] = fmt.Sprintf("'%s'", v)
-	}
-	allowedStr := strings.Join(quotedValues, ", ")
+	compared, comparedValues := foldCase(columnName, allowedValues, ignoreCase)
+	allowedStr := quoteLiterals(comparedValues)
 
-	subQuery := fmt.Sprintf("SELECT DISTINCT %s FROM '%s' WHERE %s NOT IN (%s) AND %s IS NOT NULL",
-		columnName, dataPath, columnName, allowedStr, columnName)
+	subQuery := fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s NOT IN (%s) AND %s IS NOT NULL",
+		compared, c.scanRows(dataPath), compared, allowedStr, quoteIdent(columnName))
 	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
 
 	var errorCount int64
@@ -856,10 +1862,4223 @@ func (c *DataQualityChecker) AreDistinctValuesInSet(dataPath, columnName string,
 	params := map[string]interface{}{
 		"column":      columnName,
 		"allowed":     allowedValues,
+		"ignore_case": ignoreCase,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("are_distinct_values_in_set", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// GroupSumMatchesReference checks if the sum of a numeric column per group equals the total recorded in a reference file.
+// This reconciles allocations against their expected totals (e.g. per-account allocations vs. account totals).
+// It sums valueCol grouped by groupBy, LEFT JOINs the groups to the reference on refKeyCol and flags groups
+// whose sum differs from refValueCol by more than tolerance, or which have no reference row at all.
+// When grouping by several columns, refKeyCol is a comma-separated list of reference columns matched positionally.
+func (c *DataQualityChecker) GroupSumMatchesReference(dataPath, valueCol string, groupBy []string, refPath, refKeyCol, refValueCol string, tolerance float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(refPath); err != nil {
+		return false, err
+	}
+	if tolerance < 0 {
+		return false, fmt.Errorf("tolerance must be non-negative, got %v", tolerance)
+	}
+
+	refKeys := strings.Split(refKeyCol, ",")
+	for i := range refKeys {
+		refKeys[i] = strings.TrimSpace(refKeys[i])
+	}
+	if len(groupBy) == 0 || len(refKeys) != len(groupBy) {
+		return false, fmt.Errorf("group by columns %v do not match reference key columns %v", groupBy, refKeys)
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	var joinConditionsParts []string
+	var groupKeyParts []string
+	for i, key := range groupBy {
+		joinConditionsParts = append(joinConditionsParts, fmt.Sprintf("g.%s = r.%s", quoteIdent(key), quoteIdent(refKeys[i])))
+		groupKeyParts = append(groupKeyParts, fmt.Sprintf("CAST(g.%s AS VARCHAR)", quoteIdent(key)))
+	}
+	quotedGroupBy := make([]string, len(groupBy))
+	for i, key := range groupBy {
+		quotedGroupBy[i] = quoteIdent(key)
+	}
+	groupByStr := strings.Join(quotedGroupBy, ", ")
+
+	// Groups with no reference row get a NULL difference and are treated as mismatches
+	query := fmt.Sprintf(`
+		WITH g AS (
+			SELECT %s, SUM(%s) AS group_sum FROM %s GROUP BY %s
+		), j AS (
+			SELECT concat_ws(',', %s) AS group_key, ABS(g.group_sum - r.%s) AS diff
+			FROM g LEFT JOIN %s r ON %s
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE diff IS NULL OR diff > %f),
+			(SELECT group_key FROM j ORDER BY diff DESC NULLS FIRST LIMIT 1),
+			(SELECT diff FROM j ORDER BY diff DESC NULLS FIRST LIMIT 1)
+		FROM j
+	`, groupByStr, quoteIdent(valueCol), c.scanRows(dataPath), groupByStr,
+		strings.Join(groupKeyParts, ", "), quoteIdent(refValueCol), c.scan(refPath), strings.Join(joinConditionsParts, " AND "), tolerance)
+
+	var errorCount int64
+	var worstGroup sql.NullString
+	var worstDiff sql.NullFloat64
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &worstGroup, &worstDiff)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	// A NULL delta means the worst group has no reference row
+	var worstDelta interface{}
+	if worstDiff.Valid {
+		worstDelta = worstDiff.Float64
+	}
+
+	params := map[string]interface{}{
+		"value_column":      valueCol,
+		"group_by":          groupBy,
+		"reference_path":    refPath,
+		"reference_key":     refKeys,
+		"reference_value":   refValueCol,
+		"tolerance":         tolerance,
+		"data_path":         dataPath,
+		"error_count":       errorCount,
+		"worst_group":       worstGroup.String,
+		"worst_group_delta": worstDelta,
+	}
+	if err := c.logResult("group_sum_matches_reference", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnValidChecksum checks if every non-null value in a column carries a valid check digit.
+// Internal IDs often embed a checksum to catch typos and transpositions, which regexes cannot verify.
+// It fetches distinct values with their row counts via DuckDB and validates each with the named
+// algorithm (luhn, mod11 or verhoeff), counting the rows whose value fails.
+func (c *DataQualityChecker) IsColumnValidChecksum(dataPath, columnName, algorithm string) (bool, error) {
+	start := time.Now()
+	validate, ok := checksumAlgorithms[strings.ToLower(algorithm)]
+	if !ok {
+		return false, fmt.Errorf("unsupported checksum algorithm: %s (supported: luhn, mod11, verhoeff)", algorithm)
+	}
+
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT CAST(%s AS VARCHAR), COUNT(*) FROM %s WHERE %s IS NOT NULL GROUP BY 1",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var errorCount int64
+	for rows.Next() {
+		var value string
+		var count int64
+		if err := rows.Scan(&value, &count); err != nil {
+			return false, err
+		}
+		if !validate(strings.TrimSpace(value)) {
+			errorCount += count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"algorithm":   strings.ToLower(algorithm),
 		"data_path":   dataPath,
 		"error_count": errorCount,
 	}
-	if err := c.dbConnector.Log("are_distinct_values_in_set", result, params); err != nil {
+	if err := c.logResult("is_column_valid_checksum", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// DistinctCountStable checks if a column's distinct-value count has not collapsed versus a baseline file.
+// A feature suddenly taking far fewer values usually signals upstream breakage (category collapse).
+// It runs COUNT(DISTINCT column) on both files and fails if current / baseline is below minRatio.
+func (c *DataQualityChecker) DistinctCountStable(dataPath, baselinePath, columnName string, minRatio float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(baselinePath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT (SELECT COUNT(DISTINCT %s) FROM %s), (SELECT COUNT(DISTINCT %s) FROM %s)",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), c.scan(baselinePath))
+
+	var currentCount, baselineCount int64
+	err = duckInfo.QueryRow(query).Scan(&currentCount, &baselineCount)
+	if err != nil {
+		return false, err
+	}
+	if baselineCount == 0 {
+		return false, fmt.Errorf("baseline column '%s' in '%s' has no non-null values", columnName, baselinePath)
+	}
+
+	ratio := float64(currentCount) / float64(baselineCount)
+	result := ratio >= minRatio
+
+	params := map[string]interface{}{
+		"column":         columnName,
+		"distinct_count": currentCount,
+		"baseline_count": baselineCount,
+		"ratio":          ratio,
+		"min_ratio":      minRatio,
+		"data_path":      dataPath,
+		"baseline_path":  baselinePath,
+	}
+	if err := c.logResult("distinct_count_stable", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// RowChurnBelow checks if the fraction of rows that changed between two snapshots is at most maxChurnRate.
+// Too many changed rows between runs usually means a full reprocess or an upstream bug rather than real updates.
+// It inner-joins the snapshots on keyColumn and counts matched rows where any other column present in both
+// files IS DISTINCT FROM its counterpart; the churn rate is that count over the matched rows, and is logged.
+func (c *DataQualityChecker) RowChurnBelow(dataPathA, dataPathB, keyColumn string, maxChurnRate float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPathA); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(dataPathB); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	columnsA, err := c.describeColumns(duckInfo, dataPathA)
+	if err != nil {
+		return false, err
+	}
+	columnsB, err := c.describeColumns(duckInfo, dataPathB)
+	if err != nil {
+		return false, err
+	}
+	inB := make(map[string]bool, len(columnsB))
+	for _, col := range columnsB {
+		inB[col] = true
+	}
+
+	var changed []string
+	for _, col := range columnsA {
+		if col == keyColumn || !inB[col] {
+			continue
+		}
+		changed = append(changed, fmt.Sprintf("a.%s IS DISTINCT FROM b.%s", quoteIdent(col), quoteIdent(col)))
+	}
+	changedExpr := "false"
+	if len(changed) > 0 {
+		changedExpr = strings.Join(changed, " OR ")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT COUNT(*) FILTER (WHERE %s), COUNT(*)
+		FROM %s a JOIN %s b ON a.%s = b.%s
+	`, changedExpr, c.scan(dataPathA), c.scan(dataPathB), quoteIdent(keyColumn), quoteIdent(keyColumn))
+
+	var changedCount, matchedCount int64
+	err = duckInfo.QueryRow(query).Scan(&changedCount, &matchedCount)
+	if err != nil {
+		return false, err
+	}
+
+	churnRate := 0.0
+	if matchedCount > 0 {
+		churnRate = float64(changedCount) / float64(matchedCount)
+	}
+	result := churnRate <= maxChurnRate
+
+	params := map[string]interface{}{
+		"key_column":     keyColumn,
+		"data_path":      dataPathA,
+		"other_path":     dataPathB,
+		"error_count":    changedCount,
+		"matched_count":  matchedCount,
+		"churn_rate":     churnRate,
+		"max_churn_rate": maxChurnRate,
+	}
+	if err := c.logResult("row_churn_below", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListElementsBetween checks if every element of a delimited list column is a number within [min, max].
+// List-valued columns (e.g. "10,20,30") hide out-of-range values from plain range checks.
+// It splits each non-null value with string_split and uses list_filter to flag rows holding any
+// element that is non-numeric or outside the range.
+func (c *DataQualityChecker) ListElementsBetween(dataPath, columnName, delimiter string, min, max float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if delimiter == "" {
+		return false, fmt.Errorf("delimiter must not be empty")
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT list_filter(string_split(CAST(%s AS VARCHAR), %s),
+			x -> TRY_CAST(trim(x) AS DOUBLE) IS NULL OR TRY_CAST(trim(x) AS DOUBLE) < %f OR TRY_CAST(trim(x) AS DOUBLE) > %f
+		) AS bad_elements
+		FROM %s WHERE %s IS NOT NULL
+	`, quoteIdent(columnName), quoteLiteral(delimiter), min, max, c.scanRows(dataPath), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s) WHERE len(bad_elements) > 0", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"delimiter":   delimiter,
+		"min":         min,
+		"max":         max,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("list_elements_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnValidGitSHA checks if non-null values in a column are lowercase hex git commit SHAs.
+// CI/CD metadata must reference real commits, and truncated or mistyped hashes break lookups.
+// It uses regexp_matches against a 40-character pattern when full is true, or the 7-character short form otherwise.
+func (c *DataQualityChecker) IsColumnValidGitSHA(dataPath, columnName string, full bool) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	length := 7
+	if full {
+		length = 40
+	}
+	pattern := fmt.Sprintf("^[0-9a-f]{%d}$", length)
+
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(CAST(%s AS VARCHAR), %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(pattern), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"full":        full,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_git_sha", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// VersionRangesContiguous checks if the [from, to) validity ranges of each entity tile the timeline without gaps or overlaps.
+// Versioned (slowly changing) records must hand over exactly where the previous version ends, or point-in-time lookups break.
+// It orders each entity's versions by fromCol and compares every range start to the previous version's end using LAG,
+// counting gaps (start after previous end) and overlaps (start before previous end) separately.
+func (c *DataQualityChecker) VersionRangesContiguous(dataPath, entityCol, fromCol, toCol string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT %s AS valid_from, LAG(%s) OVER (PARTITION BY %s ORDER BY %s) AS prev_to
+		FROM %s
+	`, quoteIdent(fromCol), quoteIdent(toCol), quoteIdent(entityCol), quoteIdent(fromCol), c.scanRows(dataPath))
+	countQuery := fmt.Sprintf(`
+		SELECT
+			COUNT(*) FILTER (WHERE valid_from > prev_to),
+			COUNT(*) FILTER (WHERE valid_from < prev_to)
+		FROM (%s)
+	`, subQuery)
+
+	var gapCount, overlapCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&gapCount, &overlapCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := gapCount == 0 && overlapCount == 0
+
+	params := map[string]interface{}{
+		"entity_column": entityCol,
+		"from_column":   fromCol,
+		"to_column":     toCol,
+		"data_path":     dataPath,
+		"gap_count":     gapCount,
+		"overlap_count": overlapCount,
+	}
+	if err := c.logResult("version_ranges_contiguous", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnJSONSafe checks if non-null values in a column survive JSON serialization unchanged.
+// Columns destined for JSON APIs must not carry raw control characters or values that re-parse differently.
+// It serializes each value with to_json, re-parses it with json_extract_string and flags rows where the
+// round trip differs from the original text or the original contains raw control characters (U+0000-U+001F).
+func (c *DataQualityChecker) IsColumnJSONSafe(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT CAST(%s AS VARCHAR) AS original, json_extract_string(to_json(CAST(%s AS VARCHAR)), '$') AS round_trip
+		FROM %s WHERE %s IS NOT NULL
+	`, quoteIdent(columnName), quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*) FROM (%s)
+		WHERE round_trip IS NULL OR round_trip != original OR regexp_matches(original, '[\x00-\x1f]')
+	`, subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_json_safe", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// jsonPointerPattern matches RFC 6901 JSON pointers: the empty string or a sequence of "/"-prefixed
+// reference tokens in which "~" only appears as the escapes "~0" and "~1"
+const jsonPointerPattern = `^(/([^~/]|~[01])*)*$`
+
+// IsColumnValidJSONPointer checks if non-null values in a column are syntactically valid RFC 6901 JSON pointers.
+// Config datasets storing pointers such as /a/b/0 break at lookup time when a pointer is malformed.
+// It uses regexp_matches against a pattern requiring a leading "/" per token and only "~0"/"~1" escapes.
+func (c *DataQualityChecker) IsColumnValidJSONPointer(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(CAST(%s AS VARCHAR), %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(jsonPointerPattern), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_json_pointer", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// HasNoDuplicateColumns checks if the file header contains no repeated column names.
+// DuckDB silently renames repeated CSV headers (id, id_1, ...), hiding what is usually an export bug.
+// For delimited text files it reads the raw first line with read_csv(header=false); for other formats
+// it falls back to the DESCRIBE column names. Duplicated names are logged.
+func (c *DataQualityChecker) HasNoDuplicateColumns(dataPath string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	var columnNames []string
+	opts := c.scanOptions
+	if isDelimitedTextFile(dataPath) && (opts.Format == "" || opts.Format == formatCSV) && !opts.NoHeader {
+		delim := ""
+		if opts.Delimiter != "" {
+			delim = ", delim=" + quoteLiteral(opts.Delimiter)
+		}
+		query := fmt.Sprintf("SELECT * FROM read_csv(%s, header=false, all_varchar=true%s) LIMIT 1", quoteLiteral(dataPath), delim)
+		rows, err := duckInfo.Query(query)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+
+		cols, err := rows.Columns()
+		if err != nil {
+			return false, err
+		}
+		if rows.Next() {
+			values := make([]sql.NullString, len(cols))
+			dest := make([]interface{}, len(cols))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+			if err := rows.Scan(dest...); err != nil {
+				return false, err
+			}
+			for _, v := range values {
+				columnNames = append(columnNames, v.String)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+	} else {
+		columnNames, err = c.describeColumns(duckInfo, dataPath)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	seen := map[string]int{}
+	var duplicates []string
+	for _, name := range columnNames {
+		seen[name]++
+		if seen[name] == 2 {
+			duplicates = append(duplicates, name)
+		}
+	}
+
+	result := len(duplicates) == 0
+
+	params := map[string]interface{}{
+		"data_path":         dataPath,
+		"duplicate_columns": duplicates,
+		"error_count":       len(duplicates),
+	}
+	if err := c.logResult("has_no_duplicate_columns", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnSignificantFiguresAtMost checks if non-null numeric values use no more than sigFigs significant figures.
+// Scientific datasets often carry a precision bound, and extra digits indicate false precision or unit errors.
+// It reads each distinct value as normalized text and counts its significant digits in Go, ignoring leading
+// and trailing zeros since typed columns do not preserve them. Offending values are logged (capped).
+func (c *DataQualityChecker) IsColumnSignificantFiguresAtMost(dataPath, columnName string, sigFigs int) (bool, error) {
+	start := time.Now()
+	if sigFigs < 1 {
+		return false, fmt.Errorf("significant figures must be at least 1, got %d", sigFigs)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT CAST(%s AS VARCHAR), COUNT(*) FROM %s WHERE %s IS NOT NULL GROUP BY 1",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var errorCount int64
+	var offenders []string
+	for rows.Next() {
+		var value string
+		var count int64
+		if err := rows.Scan(&value, &count); err != nil {
+			return false, err
+		}
+		figures, ok := countSignificantFigures(value)
+		if !ok || figures > sigFigs {
+			errorCount += count
+			if len(offenders) < maxLoggedOffenders {
+				offenders = append(offenders, value)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":       columnName,
+		"max_sig_figs": sigFigs,
+		"data_path":    dataPath,
+		"error_count":  errorCount,
+		"offenders":    offenders,
+	}
+	if err := c.logResult("is_column_significant_figures_at_most", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// countSignificantFigures counts the significant digits of a normalized decimal number written as text
+// (e.g. "-0.0012" -> 2, "4500" -> 2, "1200.5" -> 5). It returns false if the value is not a number.
+func countSignificantFigures(value string) (int, bool) {
+	s := strings.TrimSpace(value)
+	s = strings.TrimLeft(s, "+-")
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		s = s[:i]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if intPart == "" && fracPart == "" {
+		return 0, false
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+
+	// Leading zeros and trailing zeros (of the fraction, or of an integer) are not significant
+	fracPart = strings.TrimRight(fracPart, "0")
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if fracPart == "" {
+		digits = strings.TrimRight(digits, "0")
+	}
+	if digits == "" {
+		// Zero itself has one significant figure
+		return 1, true
+	}
+	return len(digits), true
+}
+
+// AllPathsExist checks if every file path listed in a column points to an existing file.
+// Manifest datasets go stale when referenced files are moved or deleted.
+// It stats each distinct non-null local path, resolving relative paths against the manifest's directory.
+// http(s) URLs are HEAD-requested when checkURLs is true (a 4xx/5xx or network error counts as missing)
+// and skipped otherwise. Missing paths are logged (capped).
+func (c *DataQualityChecker) AllPathsExist(dataPath, columnName string, checkURLs bool) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT DISTINCT CAST(%s AS VARCHAR) FROM %s WHERE %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return false, err
+		}
+		paths = append(paths, path)
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	baseDir := filepath.Dir(dataPath)
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	var missingCount, skippedCount int64
+	var missing []string
+	for _, path := range paths {
+		exists := true
+		lower := strings.ToLower(path)
+		if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+			if !checkURLs {
+				skippedCount++
+				continue
+			}
+			resp, err := client.Head(path)
+			if err != nil || resp.StatusCode >= 400 {
+				exists = false
+			}
+			if resp != nil {
+				resp.Body.Close()
+			}
+		} else {
+			localPath := path
+			if !filepath.IsAbs(localPath) {
+				localPath = filepath.Join(baseDir, localPath)
+			}
+			if _, err := os.Stat(localPath); err != nil {
+				exists = false
+			}
+		}
+
+		if !exists {
+			missingCount++
+			if len(missing) < maxLoggedOffenders {
+				missing = append(missing, path)
+			}
+		}
+	}
+
+	result := missingCount == 0
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"check_urls":    checkURLs,
+		"data_path":     dataPath,
+		"error_count":   missingCount,
+		"skipped_count": skippedCount,
+		"missing":       missing,
+	}
+	if err := c.logResult("all_paths_exist", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ConditionalEnum checks if each row's value is allowed for the row's type, e.g. subtype must be valid for its type.
+// Allowed values often depend on another column, which a flat enum check cannot express.
+// It anti-joins the data against the (type, value) pairs in mapping, flagging non-null values whose pair is not
+// listed (including rows whose type has no mapping). Offending (type, value) pairs are logged (capped).
+func (c *DataQualityChecker) ConditionalEnum(dataPath, typeCol, valueCol string, mapping map[string][]string) (bool, error) {
+	start := time.Now()
+	if len(mapping) == 0 {
+		return false, fmt.Errorf("mapping must contain at least one type")
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	// Sort types so the generated SQL is deterministic
+	types := make([]string, 0, len(mapping))
+	for t := range mapping {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	var pairs []string
+	for _, t := range types {
+		for _, v := range mapping[t] {
+			pairs = append(pairs, fmt.Sprintf("(%s, %s)", quoteLiteral(t), quoteLiteral(v)))
+		}
+	}
+	if len(pairs) == 0 {
+		// A mapping without any allowed values rejects every row
+		pairs = append(pairs, "(NULL, NULL)")
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT CAST(d.%s AS VARCHAR) AS type_value, CAST(d.%s AS VARCHAR) AS value
+		FROM %s d
+		WHERE d.%s IS NOT NULL AND NOT EXISTS (
+			SELECT 1 FROM (VALUES %s) AS allowed(type_value, value)
+			WHERE allowed.type_value = CAST(d.%s AS VARCHAR) AND allowed.value = CAST(d.%s AS VARCHAR)
+		)
+	`, quoteIdent(typeCol), quoteIdent(valueCol), c.scanRows(dataPath), quoteIdent(valueCol),
+		strings.Join(pairs, ", "), quoteIdent(typeCol), quoteIdent(valueCol))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	var offenders []string
+	if errorCount > 0 {
+		offendersQuery := fmt.Sprintf("SELECT DISTINCT type_value, value FROM (%s) ORDER BY 1, 2 LIMIT %d", subQuery, maxLoggedOffenders)
+		rows, err := duckInfo.Query(offendersQuery)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var typeValue sql.NullString
+			var value string
+			if err := rows.Scan(&typeValue, &value); err != nil {
+				return false, err
+			}
+			offenders = append(offenders, fmt.Sprintf("(%s, %s)", typeValue.String, value))
+		}
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"type_column":  typeCol,
+		"value_column": valueCol,
+		"mapping":      mapping,
+		"data_path":    dataPath,
+		"error_count":  errorCount,
+		"offenders":    offenders,
+	}
+	if err := c.logResult("conditional_enum", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnInAllocationRange checks if each row's numeric ID falls within the inclusive [min, max] block
+// assigned to the row's source in ranges, e.g. {"crm": {1, 999}, "web": {1000, 1999}}.
+// IDs outside their source's block usually mean a record was attributed to the wrong source.
+// It LEFT JOINs the data to the ranges on sourceCol and flags non-null IDs outside the block, including
+// rows whose source has no block. Offending "source: id" values are logged (capped).
+func (c *DataQualityChecker) IsColumnInAllocationRange(dataPath, idCol, sourceCol string, ranges map[string][2]int64) (bool, error) {
+	start := time.Now()
+	if len(ranges) == 0 {
+		return false, fmt.Errorf("ranges must contain at least one source")
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	// Sort sources so the generated SQL is deterministic
+	sources := make([]string, 0, len(ranges))
+	for source := range ranges {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	blocks := make([]string, len(sources))
+	for i, source := range sources {
+		block := ranges[source]
+		if block[0] > block[1] {
+			return false, fmt.Errorf("range for source '%s' has min %d greater than max %d", source, block[0], block[1])
+		}
+		blocks[i] = fmt.Sprintf("(%s, %d, %d)", quoteLiteral(source), block[0], block[1])
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT CAST(d.%s AS VARCHAR) AS source, d.%s AS id
+		FROM %s d
+		LEFT JOIN (VALUES %s) AS r(source, min_id, max_id) ON r.source = CAST(d.%s AS VARCHAR)
+		WHERE d.%s IS NOT NULL AND (r.source IS NULL OR d.%s < r.min_id OR d.%s > r.max_id)
+	`, quoteIdent(sourceCol), quoteIdent(idCol), c.scanRows(dataPath), strings.Join(blocks, ", "),
+		quoteIdent(sourceCol), quoteIdent(idCol), quoteIdent(idCol), quoteIdent(idCol))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	var offenders []string
+	if errorCount > 0 {
+		offendersQuery := fmt.Sprintf("SELECT source, CAST(id AS VARCHAR) FROM (%s) ORDER BY 1, 2 LIMIT %d", subQuery, maxLoggedOffenders)
+		rows, err := duckInfo.Query(offendersQuery)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var source sql.NullString
+			var id string
+			if err := rows.Scan(&source, &id); err != nil {
+				return false, err
+			}
+			offenders = append(offenders, fmt.Sprintf("%s: %s", source.String, id))
+		}
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"id_column":     idCol,
+		"source_column": sourceCol,
+		"ranges":        ranges,
+		"data_path":     dataPath,
+		"error_count":   errorCount,
+		"offenders":     offenders,
+	}
+	if err := c.logResult("is_column_in_allocation_range", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// AllRowsSatisfy checks if every row satisfies a boolean SQL predicate over the row's columns.
+// It backs composite rules built by the suite package, which compiles a rule tree into one predicate so that
+// the whole rule is evaluated as a single error-count query. Rows where the predicate is false or NULL fail.
+// The predicate is inserted into the query verbatim, so it must come from a trusted builder, not raw user input.
+func (c *DataQualityChecker) AllRowsSatisfy(dataPath, predicate string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE (%s) IS NOT TRUE", c.scanRows(dataPath), predicate)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"predicate":   predicate,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("all_rows_satisfy", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// dateGranularities lists the date_trunc parts accepted by IsColumnDateGranularity
+var dateGranularities = map[string]bool{"hour": true, "day": true, "month": true, "year": true}
+
+// IsColumnDateGranularity checks if non-null date/timestamp values are aligned to a granularity boundary
+// (hour, day, month or year), e.g. monthly aggregates must only hold first-of-month dates.
+// It compares each value, cast to TIMESTAMP, with its date_trunc at the granularity; values that differ, or that
+// cannot be parsed as timestamps, are counted as misaligned.
+func (c *DataQualityChecker) IsColumnDateGranularity(dataPath, columnName, granularity string) (bool, error) {
+	start := time.Now()
+	if !dateGranularities[granularity] {
+		return false, fmt.Errorf("unsupported granularity %q: must be hour, day, month, or year", granularity)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT ts FROM (SELECT TRY_CAST(%s AS TIMESTAMP) AS ts FROM %s WHERE %s IS NOT NULL)
+		WHERE ts IS NULL OR ts != date_trunc(%s, ts)
+	`, quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(granularity))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"granularity": granularity,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_date_granularity", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ValueRateNear checks if the fraction of rows whose column equals value is within expectedRate ± tolerance,
+// e.g. the share of is_fraud = true rows should stay near 2%.
+// Presence checks cannot catch a rate that drifts while the value still appears.
+// It compares the column as text, counting it with COUNT(*) FILTER over all rows (NULLs count as not matching),
+// and logs the actual rate. An empty file returns an error since the rate is undefined.
+func (c *DataQualityChecker) ValueRateNear(dataPath, columnName, value string, expectedRate, tolerance float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(*) FILTER (WHERE CAST(%s AS VARCHAR) = %s), COUNT(*) FROM %s",
+		quoteIdent(columnName), quoteLiteral(value), c.scanRows(dataPath))
+
+	var matchCount, totalCount int64
+	err = duckInfo.QueryRow(query).Scan(&matchCount, &totalCount)
+	if err != nil {
+		return false, err
+	}
+	if totalCount == 0 {
+		return false, fmt.Errorf("rate of '%s' in column '%s' is undefined: '%s' has no rows", value, columnName, dataPath)
+	}
+
+	actualRate := float64(matchCount) / float64(totalCount)
+	result := math.Abs(actualRate-expectedRate) <= tolerance
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"value":         value,
+		"match_count":   matchCount,
+		"total_count":   totalCount,
+		"actual_rate":   actualRate,
+		"expected_rate": expectedRate,
+		"tolerance":     tolerance,
+		"data_path":     dataPath,
+	}
+	if err := c.logResult("value_rate_near", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnNullFractionBelow checks that the share of null values in a column is at most maxFraction.
+// Unlike IsColumnNotNullWithTolerance, which treats an empty file as passing, an explicit null budget
+// such as "at most 5% of emails may be missing" is meaningless without rows, so empty files are an error.
+// It computes SUM(CASE WHEN col IS NULL THEN 1 ELSE 0 END) / COUNT(*) and logs the actual fraction.
+func (c *DataQualityChecker) IsColumnNullFractionBelow(dataPath, columnName string, maxFraction float64) (bool, error) {
+	start := time.Now()
+	if maxFraction < 0 || maxFraction > 1 {
+		return false, fmt.Errorf("max fraction must be within [0, 1], got %v", maxFraction)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COALESCE(SUM(CASE WHEN %s IS NULL THEN 1 ELSE 0 END), 0), COUNT(*) FROM %s",
+		quoteIdent(columnName), c.scanRows(dataPath))
+
+	var nullCount, totalCount int64
+	err = duckInfo.QueryRow(query).Scan(&nullCount, &totalCount)
+	if err != nil {
+		return false, err
+	}
+	if totalCount == 0 {
+		return false, fmt.Errorf("null fraction of column '%s' is undefined: '%s' has no rows", columnName, dataPath)
+	}
+
+	nullFraction := float64(nullCount) / float64(totalCount)
+	result := nullFraction <= maxFraction
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"error_count":   nullCount,
+		"total_count":   totalCount,
+		"null_fraction": nullFraction,
+		"max_fraction":  maxFraction,
+		"data_path":     dataPath,
+	}
+	if err := c.logResult("is_column_null_fraction_below", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ReferenceAttributeNotNull checks that every row matched in a reference file carries a non-null required attribute.
+// Existence alone is not enough when the referenced row must itself be usable (e.g. an order's customer must have a region).
+// It INNER JOINs the data to the reference on joinKey = refJoinKey and counts data rows whose matched refAttr is NULL.
+// Rows with no reference match are left to AreTablesReferentialIntegral.
+func (c *DataQualityChecker) ReferenceAttributeNotNull(dataPath, joinKey, refPath, refJoinKey, refAttr string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(refPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf(`
+		SELECT l.*
+		FROM %s l
+		JOIN %s r ON l.%s = r.%s
+		WHERE r.%s IS NULL
+	`, c.scanRows(dataPath), c.scan(refPath), quoteIdent(joinKey), quoteIdent(refJoinKey), quoteIdent(refAttr))
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"join_key":           joinKey,
+		"reference_path":     refPath,
+		"reference_join_key": refJoinKey,
+		"reference_attr":     refAttr,
+		"data_path":          dataPath,
+		"error_count":        errorCount,
+	}
+	if err := c.logResult("reference_attribute_not_null", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ColumnSumsTo checks if the sum of a numeric column over the whole file equals target within tolerance.
+// Single-dimension breakdowns (e.g. percentage shares per channel) must add up to a known total such as 100.
+// It computes SUM(col) in one query and passes when |sum - target| <= tolerance, logging the actual sum.
+func (c *DataQualityChecker) ColumnSumsTo(dataPath, columnName string, target, tolerance float64) (bool, error) {
+	start := time.Now()
+	if tolerance < 0 {
+		return false, fmt.Errorf("tolerance must be non-negative, got %v", tolerance)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT SUM(%s) FROM %s", quoteIdent(columnName), c.scanRows(dataPath))
+
+	var sum sql.NullFloat64
+	err = duckInfo.QueryRow(query).Scan(&sum)
+	if err != nil {
+		return false, err
+	}
+	if !sum.Valid {
+		return false, fmt.Errorf("sum of column '%s' is undefined: '%s' has no non-null values", columnName, dataPath)
+	}
+
+	result := math.Abs(sum.Float64-target) <= tolerance
+
+	params := map[string]interface{}{
+		"column":     columnName,
+		"actual_sum": sum.Float64,
+		"target":     target,
+		"tolerance":  tolerance,
+		"data_path":  dataPath,
+	}
+	if err := c.logResult("column_sums_to", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// NoLongRunsOfIdenticalValues checks that no value repeats in more than maxRun consecutive rows.
+// A sensor that keeps emitting the same reading is often frozen rather than genuinely stable.
+// Rows are ordered by orderBy (falling back to file order, as in IsColumnMonotonic), consecutive rows
+// with the same value are grouped into runs with LAG and a running SUM, and runs longer than maxRun are counted.
+// The longest run and its value are logged.
+func (c *DataQualityChecker) NoLongRunsOfIdenticalValues(dataPath, columnName, orderBy string, maxRun int) (bool, error) {
+	start := time.Now()
+	if maxRun < 1 {
+		return false, fmt.Errorf("max run must be at least 1, got %d", maxRun)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	windowOrder := "file_row"
+	if orderBy != "" {
+		windowOrder = quoteIdent(orderBy) + ", file_row"
+	}
+
+	// A new run starts whenever the value differs from the previous row's; NULLs form runs of their own
+	query := fmt.Sprintf(`
+		WITH ordered AS (
+			SELECT *, CASE WHEN %s IS NOT DISTINCT FROM LAG(%s) OVER (ORDER BY %s) THEN 0 ELSE 1 END AS new_run
+			FROM (SELECT *, row_number() OVER () AS file_row FROM %s)
+		), runs AS (
+			SELECT %s AS val, SUM(new_run) OVER (ORDER BY %s) AS run_id FROM ordered
+		), run_lengths AS (
+			SELECT run_id, ANY_VALUE(val) AS val, COUNT(*) AS run_length FROM runs GROUP BY run_id
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE run_length > %d),
+			COALESCE(MAX(run_length), 0),
+			(SELECT CAST(val AS VARCHAR) FROM run_lengths ORDER BY run_length DESC, run_id LIMIT 1)
+		FROM run_lengths
+	`, quoteIdent(columnName), quoteIdent(columnName), windowOrder, c.scanRows(dataPath),
+		quoteIdent(columnName), windowOrder, maxRun)
+
+	var errorCount, longestRun int64
+	var longestRunValue sql.NullString
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &longestRun, &longestRunValue)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":            columnName,
+		"order_by":          orderBy,
+		"max_run":           maxRun,
+		"longest_run":       longestRun,
+		"longest_run_value": longestRunValue.String,
+		"data_path":         dataPath,
+		"error_count":       errorCount,
+	}
+	if err := c.logResult("no_long_runs_of_identical_values", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// FilesAreIdentical checks if two datasets hold exactly the same rows, e.g. a transform's output and its expected file.
+// With keyColumns it FULL OUTER JOINs the files on the keys and counts rows only in A, rows only in B, and matched
+// rows where any other shared column IS DISTINCT FROM its counterpart. Without keys it compares whole rows with
+// EXCEPT ALL in both directions, so duplicate rows must also match in number. Columns present in only one file
+// make the check fail as well; when the files share no columns and no keys are given, no rows are compared and the
+// check fails on the column lists alone. Each category's count is logged.
+func (c *DataQualityChecker) FilesAreIdentical(dataPathA, dataPathB string, keyColumns []string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPathA); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(dataPathB); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	columnsA, err := c.describeColumns(duckInfo, dataPathA)
+	if err != nil {
+		return false, err
+	}
+	columnsB, err := c.describeColumns(duckInfo, dataPathB)
+	if err != nil {
+		return false, err
+	}
+	inA := make(map[string]bool, len(columnsA))
+	for _, col := range columnsA {
+		inA[col] = true
+	}
+	inB := make(map[string]bool, len(columnsB))
+	for _, col := range columnsB {
+		inB[col] = true
+	}
+
+	var shared, onlyInA, onlyInB []string
+	for _, col := range columnsA {
+		if inB[col] {
+			shared = append(shared, col)
+		} else {
+			onlyInA = append(onlyInA, col)
+		}
+	}
+	for _, col := range columnsB {
+		if !inA[col] {
+			onlyInB = append(onlyInB, col)
+		}
+	}
+
+	var query string
+	if len(keyColumns) > 0 {
+		isKey := make(map[string]bool, len(keyColumns))
+		var joinConditions []string
+		for _, key := range keyColumns {
+			isKey[key] = true
+			joinConditions = append(joinConditions, fmt.Sprintf("a.%s = b.%s", quoteIdent(key), quoteIdent(key)))
+		}
+		var differs []string
+		for _, col := range shared {
+			if !isKey[col] {
+				differs = append(differs, fmt.Sprintf("a.%s IS DISTINCT FROM b.%s", quoteIdent(col), quoteIdent(col)))
+			}
+		}
+		differsExpr := "false"
+		if len(differs) > 0 {
+			differsExpr = strings.Join(differs, " OR ")
+		}
+
+		// Marker columns tell which side of the outer join a row came from, even when key values are NULL
+		query = fmt.Sprintf(`
+			SELECT
+				COUNT(*) FILTER (WHERE b.__dqc_in_b IS NULL),
+				COUNT(*) FILTER (WHERE a.__dqc_in_a IS NULL),
+				COUNT(*) FILTER (WHERE a.__dqc_in_a AND b.__dqc_in_b AND (%s))
+			FROM (SELECT *, true AS __dqc_in_a FROM %s) a
+			FULL OUTER JOIN (SELECT *, true AS __dqc_in_b FROM %s) b ON %s
+		`, differsExpr, c.scan(dataPathA), c.scan(dataPathB), strings.Join(joinConditions, " AND "))
+	} else if len(shared) > 0 {
+		quotedShared := make([]string, len(shared))
+		for i, col := range shared {
+			quotedShared[i] = quoteIdent(col)
+		}
+		cols := strings.Join(quotedShared, ", ")
+		query = fmt.Sprintf(`
+			SELECT
+				(SELECT COUNT(*) FROM (SELECT %s FROM %s EXCEPT ALL SELECT %s FROM %s)),
+				(SELECT COUNT(*) FROM (SELECT %s FROM %s EXCEPT ALL SELECT %s FROM %s)),
+				0
+		`, cols, c.scan(dataPathA), cols, c.scan(dataPathB),
+			cols, c.scan(dataPathB), cols, c.scan(dataPathA))
+	}
+
+	// Without keys or shared columns there are no rows to compare; the column mismatch alone fails the check
+	var onlyInACount, onlyInBCount, differingCount int64
+	if query != "" {
+		err = duckInfo.QueryRow(query).Scan(&onlyInACount, &onlyInBCount, &differingCount)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	errorCount := onlyInACount + onlyInBCount + differingCount
+	result := errorCount == 0 && len(onlyInA) == 0 && len(onlyInB) == 0
+
+	params := map[string]interface{}{
+		"key_columns":           keyColumns,
+		"data_path":             dataPathA,
+		"other_path":            dataPathB,
+		"error_count":           errorCount,
+		"rows_only_in_data":     onlyInACount,
+		"rows_only_in_other":    onlyInBCount,
+		"rows_differing":        differingCount,
+		"columns_only_in_data":  onlyInA,
+		"columns_only_in_other": onlyInB,
+	}
+	if err := c.logResult("files_are_identical", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// HasNoNearZeroValues checks that no non-null value lies strictly within epsilon of zero, exact zeros excepted.
+// This is HasNoNearZeroValuesIncludingZero with includeZero unset.
+func (c *DataQualityChecker) HasNoNearZeroValues(dataPath, columnName string, epsilon float64) (bool, error) {
+	return c.HasNoNearZeroValuesIncludingZero(dataPath, columnName, epsilon, false)
+}
+
+// HasNoNearZeroValuesIncludingZero checks that no non-null value satisfies abs(col) < epsilon.
+// Measurements that should never come close to zero (e.g. a pressure reading) indicate a sensor dropout when they do.
+// Exact zeros are flagged only when includeZero is set, since some columns use 0 as a legitimate "off" value.
+func (c *DataQualityChecker) HasNoNearZeroValuesIncludingZero(dataPath, columnName string, epsilon float64, includeZero bool) (bool, error) {
+	start := time.Now()
+	if epsilon <= 0 {
+		return false, fmt.Errorf("epsilon must be positive, got %v", epsilon)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	violation := fmt.Sprintf("ABS(%s) < %v", quoteIdent(columnName), epsilon)
+	if !includeZero {
+		violation += fmt.Sprintf(" AND %s != 0", quoteIdent(columnName))
+	}
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE %s IS NOT NULL AND %s", c.scanRows(dataPath), quoteIdent(columnName), violation)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":       columnName,
+		"epsilon":      epsilon,
+		"include_zero": includeZero,
+		"data_path":    dataPath,
+		"error_count":  errorCount,
+	}
+	if err := c.logResult("has_no_near_zero_values", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// epochUnitsPerSecond maps the epoch units accepted by IsColumnValidEpoch to how many of them make one second
+var epochUnitsPerSecond = map[string]float64{
+	"s":  1,
+	"ms": 1e3,
+	"us": 1e6,
+	"ns": 1e9,
+}
+
+// IsColumnValidEpoch checks if every non-null value, read as a Unix epoch in unit (s, ms, us or ns),
+// falls within the years minYear through maxYear inclusive.
+// Mixing epoch units silently corrupts time: seconds read as milliseconds land in January 1970, and
+// milliseconds read as seconds land tens of thousands of years ahead, so a sane year range catches both.
+// The bounds are computed in Go as epoch seconds and compared with value / units-per-second, which avoids
+// timestamp overflow for wildly out-of-range values. Values that are not numbers are flagged as well.
+func (c *DataQualityChecker) IsColumnValidEpoch(dataPath, columnName, unit string, minYear, maxYear int) (bool, error) {
+	start := time.Now()
+	perSecond, ok := epochUnitsPerSecond[unit]
+	if !ok {
+		return false, fmt.Errorf("unsupported epoch unit %q: must be s, ms, us or ns", unit)
+	}
+	if minYear > maxYear {
+		return false, fmt.Errorf("min year %d is after max year %d", minYear, maxYear)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	lower := time.Date(minYear, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	upper := time.Date(maxYear+1, time.January, 1, 0, 0, 0, 0, time.UTC).Unix()
+	seconds := fmt.Sprintf("TRY_CAST(%s AS DOUBLE) / %v", quoteIdent(columnName), perSecond)
+
+	subQuery := fmt.Sprintf(`
+		SELECT * FROM %s
+		WHERE %s IS NOT NULL AND (%s IS NULL OR %s < %d OR %s >= %d)
+	`, c.scanRows(dataPath), quoteIdent(columnName), seconds, seconds, lower, seconds, upper)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"unit":        unit,
+		"min_year":    minYear,
+		"max_year":    maxYear,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_epoch", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// HasNoDuplicateRows checks that no row is repeated in full across all columns.
+// Single-column uniqueness misses exact duplicates introduced by replayed loads or double-appended files.
+// The schema is DESCRIBEd to build an explicit GROUP BY over every column, which works for wide files,
+// and groups with COUNT(*) > 1 are counted. The number of duplicated groups and surplus rows are logged.
+func (c *DataQualityChecker) HasNoDuplicateRows(dataPath string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	columns, err := c.describeColumns(duckInfo, dataPath)
+	if err != nil {
+		return false, err
+	}
+	quotedColumns := make([]string, len(columns))
+	for i, col := range columns {
+		quotedColumns[i] = quoteIdent(col)
+	}
+	columnsStr := strings.Join(quotedColumns, ", ")
+
+	subQuery := fmt.Sprintf("SELECT COUNT(*) AS copies FROM %s GROUP BY %s HAVING COUNT(*) > 1", c.scanRows(dataPath), columnsStr)
+	countQuery := fmt.Sprintf("SELECT COUNT(*), COALESCE(SUM(copies - 1), 0) FROM (%s)", subQuery)
+
+	var errorCount, duplicateRows int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount, &duplicateRows)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":      dataPath,
+		"column_count":   len(columns),
+		"error_count":    errorCount,
+		"duplicate_rows": duplicateRows,
+	}
+	if err := c.logResult("has_no_duplicate_rows", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsMonotonicAfterThreshold checks that a series strictly increases once it first exceeds threshold.
+// Some series are flat before they become active (e.g. a meter that reads 0 until activation, then counts usage),
+// so the flat prefix is ignored and only the active region is enforced.
+// Rows are ordered by orderBy (falling back to file order, as in IsColumnMonotonic); the active region starts at
+// the first row whose value is above threshold, and every later row must be greater than the row before it.
+// Violations in the active region and the row position where it starts are logged.
+func (c *DataQualityChecker) IsMonotonicAfterThreshold(dataPath, valueCol, orderBy string, threshold float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	windowOrder := "file_row"
+	if orderBy != "" {
+		windowOrder = quoteIdent(orderBy) + ", file_row"
+	}
+
+	query := fmt.Sprintf(`
+		WITH ordered AS (
+			SELECT %s AS val, LAG(%s) OVER (ORDER BY %s) AS prev_val, row_number() OVER (ORDER BY %s) AS seq
+			FROM (SELECT *, row_number() OVER () AS file_row FROM %s)
+		), activation AS (
+			SELECT MIN(seq) AS start_seq FROM ordered WHERE val > %v
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE seq > start_seq AND val <= prev_val),
+			ANY_VALUE(start_seq)
+		FROM ordered, activation
+	`, quoteIdent(valueCol), quoteIdent(valueCol), windowOrder, windowOrder, c.scanRows(dataPath), threshold)
+
+	var errorCount int64
+	var activeFrom sql.NullInt64
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &activeFrom)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	// A series that never exceeds the threshold has no active region and trivially passes
+	var activeFromRow interface{}
+	if activeFrom.Valid {
+		activeFromRow = activeFrom.Int64
+	}
+
+	params := map[string]interface{}{
+		"column":          valueCol,
+		"order_by":        orderBy,
+		"threshold":       threshold,
+		"active_from_row": activeFromRow,
+		"data_path":       dataPath,
+		"error_count":     errorCount,
+	}
+	if err := c.logResult("is_monotonic_after_threshold", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// comparisonOperators is the allow-list of operators accepted by IsColumnComparison.
+// The operator is spliced into SQL, so anything outside this list is rejected rather than escaped.
+var comparisonOperators = map[string]bool{
+	">":  true,
+	">=": true,
+	"<":  true,
+	"<=": true,
+	"=":  true,
+	"!=": true,
+}
+
+// IsColumnComparison checks that col1 op col2 holds on every row, e.g. end_date >= start_date or
+// discount_price <= list_price. op must be one of >, >=, <, <=, = or !=.
+// Rows where either column is NULL are not counted, since the relationship is undefined there; pair it with
+// IsColumnNotNull when both values are required.
+func (c *DataQualityChecker) IsColumnComparison(dataPath, col1, op, col2 string) (bool, error) {
+	start := time.Now()
+	if !comparisonOperators[op] {
+		return false, fmt.Errorf("unsupported operator %q: must be one of >, >=, <, <=, =, !=", op)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE (%s %s %s) IS FALSE", c.scanRows(dataPath), quoteIdent(col1), op, quoteIdent(col2))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"col1":        col1,
+		"op":          op,
+		"col2":        col2,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_comparison", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// HasWellFormedQuoting checks that a delimited text file has no unbalanced or stray quotes.
+// Malformed quoting such as "unterminated silently merges the following rows into one field in lenient parsers,
+// including DuckDB's sniffer, so the file is read with Go's strict CSV reader instead of DuckDB.
+// Quoting errors are counted and the line of the first one is logged. Field counts are not checked here.
+// The delimiter comes from the scan options, defaulting to a tab for .tsv files and a comma otherwise.
+func (c *DataQualityChecker) HasWellFormedQuoting(dataPath string) (bool, error) {
+	start := time.Now()
+	file, err := os.Open(dataPath)
+	if os.IsNotExist(err) {
+		return false, fmt.Errorf("data path not found: %s", dataPath)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	if c.scanOptions.Delimiter != "" {
+		reader.Comma = []rune(c.scanOptions.Delimiter)[0]
+	} else if strings.HasSuffix(strings.ToLower(dataPath), ".tsv") {
+		reader.Comma = '\t'
+	}
+
+	var errorCount int64
+	var firstBadLine int
+	for {
+		_, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && (errors.Is(err, csv.ErrQuote) || errors.Is(err, csv.ErrBareQuote)) {
+			if errorCount == 0 {
+				firstBadLine = parseErr.StartLine
+			}
+			errorCount++
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if !result {
+		params["first_offending_line"] = firstBadLine
+	}
+	if err := c.logResult("has_well_formed_quoting", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsConditionTrue checks a conditional rule: every row matching whenPredicate must also satisfy thenPredicate,
+// e.g. "if status = 'shipped' then shipped_at IS NOT NULL". Rows not matching whenPredicate are ignored, and
+// matching rows where thenPredicate is false or NULL fail.
+// Like AllRowsSatisfy, both predicates are inserted into the query verbatim. Callers must either build them
+// (as the suite package does from structured rules) or require the user to explicitly opt in to raw SQL.
+func (c *DataQualityChecker) IsConditionTrue(dataPath, whenPredicate, thenPredicate string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT * FROM %s WHERE (%s) IS TRUE AND (%s) IS NOT TRUE", c.scanRows(dataPath), whenPredicate, thenPredicate)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"when":        whenPredicate,
+		"then":        thenPredicate,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_condition_true", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// RuleResult is the outcome of one named rule evaluated by RunRuleFile
+type RuleResult struct {
+	Name       string
+	Expression string
+	Passed     bool
+	ErrorCount int64
+}
+
+// loadRuleFile reads a YAML rulebook mapping rule names to SQL boolean expressions, keeping the file's order
+func loadRuleFile(rulesPath string) ([]RuleResult, error) {
+	content, err := os.ReadFile(rulesPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rules file: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse rules file: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode || len(doc.Content[0].Content) == 0 {
+		return nil, fmt.Errorf("rules file %s must map rule names to SQL expressions", rulesPath)
+	}
+
+	pairs := doc.Content[0].Content
+	rules := make([]RuleResult, 0, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		name, expr := pairs[i], pairs[i+1]
+		if expr.Kind != yaml.ScalarNode || strings.TrimSpace(expr.Value) == "" {
+			return nil, fmt.Errorf("rule %q: expression must be a non-empty string", name.Value)
+		}
+		rules = append(rules, RuleResult{Name: name.Value, Expression: expr.Value})
+	}
+	return rules, nil
+}
+
+// RunRuleFile evaluates every rule of a central rulebook against a data file. The rulebook is a YAML file
+// mapping rule names to SQL boolean expressions over the row's columns, e.g. "amount is positive: amount > 0".
+// All rules are counted in a single scan with one COUNT(*) FILTER per rule; rows where a rule is false or NULL
+// fail it. Each rule's outcome is logged with the rule name as its check type.
+// Expressions run verbatim, so rulebooks must come from a trusted source, like the predicates of AllRowsSatisfy.
+func (c *DataQualityChecker) RunRuleFile(dataPath, rulesPath string) ([]RuleResult, error) {
+	start := time.Now()
+	rules, err := loadRuleFile(rulesPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return nil, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]string, len(rules))
+	for i, rule := range rules {
+		filters[i] = fmt.Sprintf("COUNT(*) FILTER (WHERE (%s) IS NOT TRUE)", rule.Expression)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(filters, ", "), c.scanRows(dataPath))
+
+	dest := make([]interface{}, len(rules))
+	for i := range rules {
+		dest[i] = &rules[i].ErrorCount
+	}
+	if err := duckInfo.QueryRow(query).Scan(dest...); err != nil {
+		return nil, fmt.Errorf("failed to evaluate rules from %s: %w", rulesPath, err)
+	}
+	// The rules share one scan, so each is logged with its duration rather than a running total
+	elapsed := time.Since(start)
+
+	for i := range rules {
+		rules[i].Passed = rules[i].ErrorCount == 0
+		params := map[string]interface{}{
+			"expression":  rules[i].Expression,
+			"rules_path":  rulesPath,
+			"data_path":   dataPath,
+			"error_count": rules[i].ErrorCount,
+		}
+		if err := c.logResultWithDuration(rules[i].Name, rules[i].Passed, params, elapsed); err != nil {
+			return rules, fmt.Errorf("failed to log result: %w", err)
+		}
+	}
+
+	return rules, nil
+}
+
+// typedColumn is a column name with its type, as declared in a CREATE TABLE statement or reported by DESCRIBE
+type typedColumn struct {
+	Name string
+	Type string
+}
+
+// ddlConstraintPattern marks where a column definition's type ends and its constraints begin
+var ddlConstraintPattern = regexp.MustCompile(`(?i)\s+(NOT\s+NULL|NULL|DEFAULT|PRIMARY\s+KEY|UNIQUE|CHECK|REFERENCES|COLLATE|GENERATED|AS)\b`)
+
+// ddlTableConstraints are the leading keywords of table-level constraint entries, which declare no column
+var ddlTableConstraints = map[string]bool{"PRIMARY": true, "UNIQUE": true, "CHECK": true, "FOREIGN": true, "CONSTRAINT": true}
+
+// splitTopLevel splits s on sep, ignoring separators inside parentheses or quotes
+func splitTopLevel(s string, sep rune) []string {
+	var parts []string
+	depth, start := 0, 0
+	var quote rune
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// parseDDLColumns extracts the column names and declared types of a CREATE TABLE statement, in order.
+// Table-level constraints are skipped, column constraints (NOT NULL, DEFAULT, ...) are stripped from the type,
+// and quoted names keep their exact spelling.
+func parseDDLColumns(ddl string) ([]typedColumn, error) {
+	statement := strings.TrimSpace(ddl)
+	open := strings.Index(statement, "(")
+	header := strings.Fields(strings.ToUpper(statement[:max(open, 0)]))
+	if open < 0 || len(header) < 2 || header[0] != "CREATE" || !strings.Contains(strings.Join(header, " "), "TABLE") {
+		return nil, errors.New("DDL must be a CREATE TABLE statement with a column list")
+	}
+
+	depth, end := 0, -1
+	var quote rune
+	for i, r := range statement[open:] {
+		if quote != 0 {
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '"', '\'':
+			quote = r
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 {
+			end = open + i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, errors.New("DDL column list is not closed")
+	}
+
+	var columns []typedColumn
+	for _, entry := range splitTopLevel(statement[open+1:end], ',') {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			return nil, errors.New("DDL column list has an empty entry")
+		}
+		if first := strings.Fields(entry)[0]; ddlTableConstraints[strings.ToUpper(first)] {
+			continue
+		}
+
+		var name, rest string
+		if entry[0] == '"' {
+			closing := -1
+			for i := 1; i < len(entry); i++ {
+				if entry[i] != '"' {
+					continue
+				}
+				if i+1 < len(entry) && entry[i+1] == '"' {
+					i++
+					continue
+				}
+				closing = i
+				break
+			}
+			if closing < 0 {
+				return nil, fmt.Errorf("DDL column %s has an unterminated quoted name", entry)
+			}
+			name = strings.ReplaceAll(entry[1:closing], `""`, `"`)
+			rest = entry[closing+1:]
+		} else {
+			fields := strings.Fields(entry)
+			name = fields[0]
+			rest = strings.TrimPrefix(entry, name)
+		}
+
+		columnType := rest
+		if loc := ddlConstraintPattern.FindStringIndex(rest); loc != nil {
+			columnType = rest[:loc[0]]
+		}
+		columnType = strings.TrimSpace(columnType)
+		if columnType == "" {
+			return nil, fmt.Errorf("DDL column %q has no type", name)
+		}
+		columns = append(columns, typedColumn{Name: name, Type: columnType})
+	}
+	if len(columns) == 0 {
+		return nil, errors.New("DDL declares no columns")
+	}
+	return columns, nil
+}
+
+// compareColumnLists diffs a file's column names against the expected ones: expected columns the file lacks, file
+// columns that were not expected, and shared columns whose position among the shared columns differs.
+func compareColumnLists(expected, actual []string) (missing, extra, outOfOrder []string) {
+	expectedSet := make(map[string]bool, len(expected))
+	for _, name := range expected {
+		expectedSet[name] = true
+	}
+	actualSet := make(map[string]bool, len(actual))
+	var actualShared []string
+	for _, name := range actual {
+		actualSet[name] = true
+		if expectedSet[name] {
+			actualShared = append(actualShared, name)
+		} else {
+			extra = append(extra, name)
+		}
+	}
+	var expectedShared []string
+	for _, name := range expected {
+		if actualSet[name] {
+			expectedShared = append(expectedShared, name)
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	for i := range expectedShared {
+		if expectedShared[i] != actualShared[i] {
+			outOfOrder = append(outOfOrder, expectedShared[i])
+		}
+	}
+	return missing, extra, outOfOrder
+}
+
+// normalizeTypes resolves DuckDB type names to the canonical spelling DESCRIBE reports, e.g. INT to INTEGER,
+// by evaluating typeof(CAST(NULL AS type)) for each in a single query
+func normalizeTypes(duckInfo *sql.DB, types []string) ([]string, error) {
+	typeExprs := make([]string, len(types))
+	for i, t := range types {
+		typeExprs[i] = fmt.Sprintf("typeof(CAST(NULL AS %s))", t)
+	}
+	normalized := make([]string, len(types))
+	dest := make([]interface{}, len(types))
+	for i := range normalized {
+		dest[i] = &normalized[i]
+	}
+	if err := duckInfo.QueryRow("SELECT " + strings.Join(typeExprs, ", ")).Scan(dest...); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// describeColumnTypes returns the columns of a data file with their types, in order, as reported by DESCRIBE
+func (c *DataQualityChecker) describeColumnTypes(duckInfo *sql.DB, dataPath string) ([]typedColumn, error) {
+	rows, err := duckInfo.Query(fmt.Sprintf("SELECT column_name, column_type FROM (DESCRIBE SELECT * FROM %s)", c.scan(dataPath)))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columns []typedColumn
+	for rows.Next() {
+		var col typedColumn
+		if err := rows.Scan(&col.Name, &col.Type); err != nil {
+			return nil, err
+		}
+		columns = append(columns, col)
+	}
+	return columns, rows.Err()
+}
+
+// MatchesDDL checks that a data file's columns match a CREATE TABLE statement exactly: the same names, in the
+// same order, with the same types. DBAs own the target DDL, so a file can be validated against it before a load
+// rather than failing halfway through one.
+// Declared types are normalized through DuckDB (typeof(CAST(NULL AS type))), so aliases such as INT and INTEGER
+// or VARCHAR(10) and VARCHAR compare equal, and are then compared to the file's DESCRIBE types. Missing and extra
+// columns, type mismatches, and shared columns in a different position are logged; each counts as one error.
+func (c *DataQualityChecker) MatchesDDL(dataPath, ddl string) (bool, error) {
+	start := time.Now()
+	declared, err := parseDDLColumns(ddl)
+	if err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	declaredTypes := make([]string, len(declared))
+	for i, col := range declared {
+		declaredTypes[i] = col.Type
+	}
+	expectedTypes, err := normalizeTypes(duckInfo, declaredTypes)
+	if err != nil {
+		return false, fmt.Errorf("invalid type in DDL: %w", err)
+	}
+
+	actual, err := c.describeColumnTypes(duckInfo, dataPath)
+	if err != nil {
+		return false, err
+	}
+	actualTypes := make(map[string]string, len(actual))
+	for _, col := range actual {
+		actualTypes[col.Name] = col.Type
+	}
+
+	declaredNames := make([]string, len(declared))
+	var typeMismatches []string
+	for i, col := range declared {
+		declaredNames[i] = col.Name
+		if actualType, ok := actualTypes[col.Name]; ok && actualType != expectedTypes[i] {
+			typeMismatches = append(typeMismatches, fmt.Sprintf("%s: expected %s, got %s", col.Name, expectedTypes[i], actualType))
+		}
+	}
+	actualNames := make([]string, len(actual))
+	for i, col := range actual {
+		actualNames[i] = col.Name
+	}
+	missing, extra, outOfOrder := compareColumnLists(declaredNames, actualNames)
+
+	errorCount := int64(len(missing) + len(extra) + len(typeMismatches) + len(outOfOrder))
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":            dataPath,
+		"declared_columns":     len(declared),
+		"error_count":          errorCount,
+		"missing_columns":      missing,
+		"extra_columns":        extra,
+		"type_mismatches":      typeMismatches,
+		"out_of_order_columns": outOfOrder,
+	}
+	if err := c.logResult("matches_ddl", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// emailPattern is the WHATWG HTML "valid e-mail address" pattern, which browsers use for <input type=email>,
+// tightened to require a dot in the domain. It is RE2-compatible, so DuckDB's regexp_matches can run it.
+var emailPattern = "^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)+$"
+
+// IsColumnValidEmail checks if non-null values in a column are well-formed email addresses.
+// Hand-written email regexes are easy to get subtly wrong and get copy-pasted between teams, so one maintained
+// pattern (emailPattern) is built in. Like IsColumnRegexMatch, it uses regexp_matches and skips NULLs.
+// It validates the format only; whether the mailbox exists is not checked.
+func (c *DataQualityChecker) IsColumnValidEmail(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(CAST(%s AS VARCHAR), %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(emailPattern), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_email", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// uuidPattern matches the canonical 8-4-4-4-12 hex UUID form, in either case
+const uuidPattern = "^(?i)[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
+
+// IsColumnValidUUID checks if non-null values in a column are UUIDs in canonical 8-4-4-4-12 hex form.
+// UUIDs are the usual primary keys of records exported from services, and a mangled one silently breaks joins.
+// Hex digits may be upper or lower case; braces, URN prefixes, and unhyphenated forms are rejected.
+// Like IsColumnValidEmail, it uses regexp_matches and skips NULLs.
+func (c *DataQualityChecker) IsColumnValidUUID(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(CAST(%s AS VARCHAR), %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(uuidPattern), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_uuid", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// NullRateStable checks if a column's null rate has not risen versus a baseline file by more than maxIncrease.
+// A climbing null rate is a classic silent degradation: every row still loads, but a field quietly stops being filled.
+// Rates are fractions of rows, so maxIncrease is in the same units (0.05 allows a rise of 5 percentage points).
+// Both rates come from one query; an empty file has no null rate and is an error.
+func (c *DataQualityChecker) NullRateStable(dataPath, baselinePath, columnName string, maxIncrease float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(baselinePath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	rateExpr := fmt.Sprintf("SELECT COUNT(*) FILTER (WHERE %s IS NULL), COUNT(*) FROM %%s", quoteIdent(columnName))
+	query := fmt.Sprintf("SELECT * FROM (%s) CROSS JOIN (%s)",
+		fmt.Sprintf(rateExpr, c.scanRows(dataPath)), fmt.Sprintf(rateExpr, c.scan(baselinePath)))
+
+	var currentNulls, currentRows, baselineNulls, baselineRows int64
+	err = duckInfo.QueryRow(query).Scan(&currentNulls, &currentRows, &baselineNulls, &baselineRows)
+	if err != nil {
+		return false, err
+	}
+	if currentRows == 0 {
+		return false, fmt.Errorf("data file '%s' has no rows", dataPath)
+	}
+	if baselineRows == 0 {
+		return false, fmt.Errorf("baseline file '%s' has no rows", baselinePath)
+	}
+
+	nullRate := float64(currentNulls) / float64(currentRows)
+	baselineRate := float64(baselineNulls) / float64(baselineRows)
+	result := nullRate-baselineRate <= maxIncrease
+
+	params := map[string]interface{}{
+		"column":             columnName,
+		"null_rate":          nullRate,
+		"baseline_null_rate": baselineRate,
+		"max_increase":       maxIncrease,
+		"data_path":          dataPath,
+		"baseline_path":      baselinePath,
+	}
+	if err := c.logResult("null_rate_stable", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// DoesSchemaMatch checks a data file's column names against an expected list, as a guard against schema drift.
+// In strict mode the file must have exactly the expected columns in the same order; otherwise every expected column
+// must be present and extra columns are allowed. Column types are not compared (see MatchesDDL for that).
+// The actual and expected column lists are logged with the differences, so drift is visible in the check history.
+func (c *DataQualityChecker) DoesSchemaMatch(dataPath string, expectedColumns []string, strict bool) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	actualColumns, err := c.describeColumns(duckInfo, dataPath)
+	if err != nil {
+		return false, err
+	}
+
+	missing, extra, outOfOrder := compareColumnLists(expectedColumns, actualColumns)
+	if !strict {
+		extra, outOfOrder = nil, nil
+	}
+
+	errorCount := int64(len(missing) + len(extra) + len(outOfOrder))
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":            dataPath,
+		"strict":               strict,
+		"expected_columns":     expectedColumns,
+		"actual_columns":       actualColumns,
+		"error_count":          errorCount,
+		"missing_columns":      missing,
+		"extra_columns":        extra,
+		"out_of_order_columns": outOfOrder,
+	}
+	if err := c.logResult("does_schema_match", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// numericLocale holds a locale's decimal separator and the separators it accepts between digit groups of three.
+// French digit groups may be split by a plain, no-break, or narrow no-break space and Swiss German ones by a straight
+// or typographic apostrophe, so those locales accept several.
+type numericLocale struct {
+	decimal string
+	groups  []string
+}
+
+// numericLocales maps the locales accepted by IsColumnNumericLocale to their number formatting conventions
+var numericLocales = map[string]numericLocale{
+	"en-US": {decimal: ".", groups: []string{","}},
+	"en-GB": {decimal: ".", groups: []string{","}},
+	"de-DE": {decimal: ",", groups: []string{"."}},
+	"es-ES": {decimal: ",", groups: []string{"."}},
+	"it-IT": {decimal: ",", groups: []string{"."}},
+	"nl-NL": {decimal: ",", groups: []string{"."}},
+	"pt-BR": {decimal: ",", groups: []string{"."}},
+	"fr-FR": {decimal: ",", groups: []string{" ", "\u00a0", "\u202f"}},
+	"de-CH": {decimal: ".", groups: []string{"'", "\u2019"}},
+}
+
+// pattern returns an RE2 pattern for an optionally signed number in the locale: digits, either plain or grouped
+// in threes by one of the group separators, optionally followed by the decimal separator and a fraction
+func (l numericLocale) pattern() string {
+	groups := make([]string, len(l.groups))
+	for i, g := range l.groups {
+		groups[i] = regexp.QuoteMeta(g)
+	}
+	return fmt.Sprintf(`^[+-]?(?:[0-9]{1,3}(?:(?:%s)[0-9]{3})+|[0-9]+)(?:%s[0-9]+)?$`,
+		strings.Join(groups, "|"), regexp.QuoteMeta(l.decimal))
+}
+
+// IsColumnNumericLocale checks if every non-null value is a number written in the given locale's conventions,
+// e.g. 1.234,56 for de-DE or 1,234.56 for en-US. A feed mixing European and US formatting is parsed into wrong
+// magnitudes downstream, so the column must consistently follow the expected locale.
+// Grouping separators are optional but, when used, must split the integer part into groups of three.
+// Values are matched as text with regexp_matches; the number of non-conforming values is logged.
+func (c *DataQualityChecker) IsColumnNumericLocale(dataPath, columnName, locale string) (bool, error) {
+	start := time.Now()
+	conventions, ok := numericLocales[locale]
+	if !ok {
+		supported := make([]string, 0, len(numericLocales))
+		for name := range numericLocales {
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+		return false, fmt.Errorf("unsupported locale %q: must be one of %s", locale, strings.Join(supported, ", "))
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	pattern := conventions.pattern()
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE NOT (regexp_matches(CAST(%s AS VARCHAR), %s)) AND %s IS NOT NULL",
+		quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), quoteLiteral(pattern), quoteIdent(columnName))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"locale":      locale,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_numeric_locale", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// ParseColumnTypes parses a comma-separated "name:TYPE" list, e.g. "id:BIGINT,price:DECIMAL(10,2)", into a map.
+// Commas inside a type's parentheses do not split entries.
+func ParseColumnTypes(spec string) (map[string]string, error) {
+	types := make(map[string]string)
+	for _, entry := range splitTopLevel(spec, ',') {
+		name, columnType, ok := strings.Cut(entry, ":")
+		name, columnType = strings.TrimSpace(name), strings.TrimSpace(columnType)
+		if !ok || name == "" || columnType == "" {
+			return nil, fmt.Errorf("invalid column type %q: must be name:TYPE", strings.TrimSpace(entry))
+		}
+		types[name] = columnType
+	}
+	return types, nil
+}
+
+// DoesSchemaTypesMatch checks the DESCRIBE type of each expected column, extending IsColumnOfType's single column
+// to a whole schema. Unlike IsColumnOfType, which tests whether values cast, it compares the inferred storage type,
+// so it catches a column that drifted to VARCHAR even when its values still look right.
+// Expected types are normalized like MatchesDDL's, and columns not in expected may have any type. Every drifted
+// column is logged as "name: expected X, got Y", and columns missing from the file are logged separately.
+func (c *DataQualityChecker) DoesSchemaTypesMatch(dataPath string, expected map[string]string) (bool, error) {
+	start := time.Now()
+	if len(expected) == 0 {
+		return false, errors.New("no expected column types given")
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	declaredTypes := make([]string, len(names))
+	for i, name := range names {
+		declaredTypes[i] = expected[name]
+	}
+	expectedTypes, err := normalizeTypes(duckInfo, declaredTypes)
+	if err != nil {
+		return false, fmt.Errorf("invalid expected type: %w", err)
+	}
+
+	actual, err := c.describeColumnTypes(duckInfo, dataPath)
+	if err != nil {
+		return false, err
+	}
+	actualTypes := make(map[string]string, len(actual))
+	for _, col := range actual {
+		actualTypes[col.Name] = col.Type
+	}
+
+	var typeMismatches, missing []string
+	for i, name := range names {
+		actualType, ok := actualTypes[name]
+		if !ok {
+			missing = append(missing, name)
+		} else if actualType != expectedTypes[i] {
+			typeMismatches = append(typeMismatches, fmt.Sprintf("%s: expected %s, got %s", name, expectedTypes[i], actualType))
+		}
+	}
+
+	errorCount := int64(len(typeMismatches) + len(missing))
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":       dataPath,
+		"expected_types":  expected,
+		"error_count":     errorCount,
+		"type_mismatches": typeMismatches,
+		"missing_columns": missing,
+	}
+	if err := c.logResult("does_schema_types_match", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnConsistentPrecision checks if every non-null timestamp in a column carries the same number of
+// fractional-second digits. Mixed precision (12:00:00 next to 12:00:00.123) breaks equality joins and
+// dedupe logic that compare timestamps as written, and usually means two producers are writing the column.
+// CSV columns are read as raw text so trailing zeros count; other formats are cast to VARCHAR. The digits after
+// the seconds' decimal point are counted per value (0 when there is none), and each precision found is logged
+// with its row count. Every row not using the most common precision counts as an error.
+func (c *DataQualityChecker) IsColumnConsistentPrecision(dataPath, timestampColumn string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf(`
+		SELECT length(regexp_extract(CAST(%s AS VARCHAR), ':[0-9]{2}\.([0-9]+)', 1)) AS digits, COUNT(*) AS n
+		FROM %s WHERE %s IS NOT NULL GROUP BY digits ORDER BY n DESC, digits`,
+		quoteIdent(timestampColumn), c.scanRowsAsText(dataPath, timestampColumn), quoteIdent(timestampColumn))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	precisions := make(map[string]int64)
+	var errorCount int64
+	first := true
+	for rows.Next() {
+		var digits, count int64
+		if err := rows.Scan(&digits, &count); err != nil {
+			return false, err
+		}
+		precisions[fmt.Sprint(digits)] = count
+		if !first {
+			errorCount += count
+		}
+		first = false
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	result := len(precisions) <= 1
+
+	params := map[string]interface{}{
+		"column":      timestampColumn,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+		"precisions":  precisions,
+	}
+	if err := c.logResult("is_column_consistent_precision", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsTableRowCountEqual checks if the table has exactly the expected number of rows, for reconciliations such as
+// "this export must have exactly 10,000 rows" where IsTableRowCountBetween's range would be min = max.
+// The actual count and its difference from expected are logged, so a near miss can be told from a wrong file.
+func (c *DataQualityChecker) IsTableRowCountEqual(dataPath string, expected int64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", c.scanRows(dataPath))
+
+	var rowCount int64
+	err = duckInfo.QueryRow(query).Scan(&rowCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := rowCount == expected
+
+	params := map[string]interface{}{
+		"row_count":  rowCount,
+		"expected":   expected,
+		"difference": rowCount - expected,
+		"data_path":  dataPath,
+	}
+	if err := c.logResult("is_table_row_count_equal", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// RefSpec names one reference file accepted by IsInAnyReference and the key column to match in it.
+// An empty Key means the reference uses the same column name as the data.
+type RefSpec struct {
+	Path string `yaml:"path"`
+	Key  string `yaml:"key"`
+}
+
+// LoadRefSpecs reads a YAML list of reference files, e.g. "- {path: eu_customers.csv, key: customer_id}"
+func LoadRefSpecs(path string) ([]RefSpec, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read references file: %w", err)
+	}
+	var refs []RefSpec
+	if err := yaml.Unmarshal(content, &refs); err != nil {
+		return nil, fmt.Errorf("failed to parse references file: %w", err)
+	}
+	for i, ref := range refs {
+		if ref.Path == "" {
+			return nil, fmt.Errorf("reference %d in %s: missing path", i+1, path)
+		}
+	}
+	return refs, nil
+}
+
+// IsInAnyReference checks that every row's key exists in at least one of several reference files, e.g. when rows
+// are routed to one of several downstream systems and each system's accepted keys live in their own file.
+// The data is LEFT JOINed to the distinct keys of each reference, and rows matching none of them are counted.
+// As in AreTablesReferentialIntegral, a NULL key matches nothing and is counted too.
+func (c *DataQualityChecker) IsInAnyReference(dataPath, keyCol string, refs []RefSpec) (bool, error) {
+	start := time.Now()
+	if len(refs) == 0 {
+		return false, errors.New("at least one reference is required")
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	for _, ref := range refs {
+		if err := c.validatePathExists(ref.Path); err != nil {
+			return false, err
+		}
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	joins := make([]string, len(refs))
+	unmatched := make([]string, len(refs))
+	refPaths := make([]string, len(refs))
+	for i, ref := range refs {
+		refKey := ref.Key
+		if refKey == "" {
+			refKey = keyCol
+		}
+		alias := fmt.Sprintf("r%d", i)
+		joins[i] = fmt.Sprintf("LEFT JOIN (SELECT DISTINCT %s AS k FROM %s) %s ON l.%s = %s.k",
+			quoteIdent(refKey), c.scan(ref.Path), alias, quoteIdent(keyCol), alias)
+		unmatched[i] = alias + ".k IS NULL"
+		refPaths[i] = ref.Path
+	}
+
+	subQuery := fmt.Sprintf("SELECT l.* FROM %s l %s WHERE %s",
+		c.scanRows(dataPath), strings.Join(joins, " "), strings.Join(unmatched, " AND "))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"key_column":      keyCol,
+		"reference_paths": refPaths,
+		"data_path":       dataPath,
+		"error_count":     errorCount,
+	}
+	if err := c.logResult("is_in_any_reference", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// AreRowCountsEqual checks if two files have the same number of rows, e.g. a transform's source and its output.
+// Unlike referential integrity, which only asks whether keys exist, this catches rows a transform dropped or
+// duplicated. Both counts come from one query; they and their difference (A minus B) are logged.
+func (c *DataQualityChecker) AreRowCountsEqual(dataPathA, dataPathB string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPathA); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(dataPathB); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT (SELECT COUNT(*) FROM %s), (SELECT COUNT(*) FROM %s)", c.scanRows(dataPathA), c.scan(dataPathB))
+
+	var countA, countB int64
+	err = duckInfo.QueryRow(query).Scan(&countA, &countB)
+	if err != nil {
+		return false, err
+	}
+
+	result := countA == countB
+
+	params := map[string]interface{}{
+		"data_path":       dataPathA,
+		"reference_path":  dataPathB,
+		"row_count":       countA,
+		"reference_count": countB,
+		"difference":      countA - countB,
+	}
+	if err := c.logResult("are_row_counts_equal", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnPositive checks if every non-null value in a column is greater than zero, e.g. prices or quantities.
+// IsColumnBetween can approximate this only by picking an arbitrary upper bound, which reads poorly in suites.
+func (c *DataQualityChecker) IsColumnPositive(dataPath, columnName string) (bool, error) {
+	return c.isColumnAboveZero("is_column_positive", dataPath, columnName, false)
+}
+
+// IsColumnNonNegative checks if every non-null value in a column is zero or greater, e.g. counts or balances.
+func (c *DataQualityChecker) IsColumnNonNegative(dataPath, columnName string) (bool, error) {
+	return c.isColumnAboveZero("is_column_non_negative", dataPath, columnName, true)
+}
+
+// isColumnAboveZero counts non-null values at or below zero (below zero when allowZero is set) and logs them
+// under checkType; it backs IsColumnPositive and IsColumnNonNegative
+func (c *DataQualityChecker) isColumnAboveZero(checkType, dataPath, columnName string, allowZero bool) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	op := "<="
+	if allowZero {
+		op = "<"
+	}
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s %s 0", quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), op)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult(checkType, result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// psiBins is how many quantile bins PopulationStabilityIndexBelow splits a numeric column into
+const psiBins = 10
+
+// psiMinShare stands in for the share of an empty bin, whose log ratio would otherwise be infinite
+const psiMinShare = 0.0001
+
+// psiLoggedBins caps how many of the most-shifted bins PopulationStabilityIndexBelow logs
+const psiLoggedBins = 3
+
+// BinShift is one bin of a population stability comparison: its share of the baseline and of the current data,
+// and its contribution to the PSI
+type BinShift struct {
+	Bin          string  `json:"bin"`
+	Baseline     float64 `json:"baseline"`
+	Current      float64 `json:"current"`
+	Contribution float64 `json:"contribution"`
+}
+
+// binShares counts the non-null values of a file per bin, given a SQL expression computing each value's bin label,
+// and returns each bin's share of the total
+func binShares(duckInfo *sql.DB, scan, columnName, binExpr string) (map[string]float64, error) {
+	query := fmt.Sprintf("SELECT %s AS bin, COUNT(*) FROM %s WHERE %s IS NOT NULL GROUP BY bin",
+		binExpr, scan, quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	var total int64
+	for rows.Next() {
+		var bin string
+		var count int64
+		if err := rows.Scan(&bin, &count); err != nil {
+			return nil, err
+		}
+		counts[bin] = count
+		total += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	shares := make(map[string]float64, len(counts))
+	for bin, count := range counts {
+		shares[bin] = float64(count) / float64(total)
+	}
+	return shares, nil
+}
+
+// PopulationStabilityIndexBelow checks if a column's distribution has not drifted from a baseline file, using the
+// population stability index (PSI) that ML monitoring uses for feature drift. It fails when PSI exceeds maxPSI;
+// common rules of thumb read below 0.1 as stable, 0.1 to 0.2 as a moderate shift, and above 0.2 as a major one.
+// Numeric columns are split into psiBins bins at the baseline's deciles, so each baseline bin holds about a tenth of
+// the rows; any other column gets one bin per distinct value. PSI sums (current - baseline) * ln(current / baseline)
+// over the bin shares, with empty bins counted as psiMinShare. NULLs are ignored. The PSI and the psiLoggedBins
+// bins contributing most to it are logged.
+func (c *DataQualityChecker) PopulationStabilityIndexBelow(dataPath, baselinePath, columnName string, maxPSI float64) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(baselinePath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	var columnType string
+	typeQuery := fmt.Sprintf("SELECT column_type FROM (DESCRIBE SELECT * FROM %s) WHERE column_name = %s",
+		c.scan(baselinePath), quoteLiteral(columnName))
+	err = duckInfo.QueryRow(typeQuery).Scan(&columnType)
+	if err == sql.ErrNoRows {
+		return false, fmt.Errorf("column %q not found in %s", columnName, baselinePath)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	binExpr := fmt.Sprintf("CAST(%s AS VARCHAR)", quoteIdent(columnName))
+	if isNumericType(columnType) {
+		quantiles := make([]string, psiBins-1)
+		for i := range quantiles {
+			quantiles[i] = fmt.Sprintf("quantile_cont(%s, %f)", quoteIdent(columnName), float64(i+1)/psiBins)
+		}
+		cutValues := make([]sql.NullFloat64, len(quantiles))
+		dest := make([]interface{}, len(cutValues))
+		for i := range cutValues {
+			dest[i] = &cutValues[i]
+		}
+		query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(quantiles, ", "), c.scan(baselinePath))
+		if err := duckInfo.QueryRow(query).Scan(dest...); err != nil {
+			return false, err
+		}
+
+		// Ties in the baseline can repeat a decile; repeated cut points would only create empty bins
+		var cuts []float64
+		for _, cut := range cutValues {
+			if cut.Valid && (len(cuts) == 0 || cut.Float64 > cuts[len(cuts)-1]) {
+				cuts = append(cuts, cut.Float64)
+			}
+		}
+		if len(cuts) > 0 {
+			cases := make([]string, len(cuts))
+			lower := "-inf"
+			for i, cut := range cuts {
+				cases[i] = fmt.Sprintf("WHEN %s <= %v THEN '(%s, %v]'", quoteIdent(columnName), cut, lower, cut)
+				lower = fmt.Sprint(cut)
+			}
+			binExpr = fmt.Sprintf("CASE %s ELSE '(%s, inf)' END", strings.Join(cases, " "), lower)
+		}
+	}
+
+	baselineShares, err := binShares(duckInfo, c.scan(baselinePath), columnName, binExpr)
+	if err != nil {
+		return false, err
+	}
+	currentShares, err := binShares(duckInfo, c.scanRows(dataPath), columnName, binExpr)
+	if err != nil {
+		return false, err
+	}
+	if len(baselineShares) == 0 {
+		return false, fmt.Errorf("baseline column '%s' in '%s' has no non-null values", columnName, baselinePath)
+	}
+	if len(currentShares) == 0 {
+		return false, fmt.Errorf("column '%s' in '%s' has no non-null values", columnName, dataPath)
+	}
+
+	bins := make(map[string]bool, len(baselineShares))
+	for bin := range baselineShares {
+		bins[bin] = true
+	}
+	for bin := range currentShares {
+		bins[bin] = true
+	}
+	var psi float64
+	shifts := make([]BinShift, 0, len(bins))
+	for bin := range bins {
+		baseline := math.Max(baselineShares[bin], psiMinShare)
+		current := math.Max(currentShares[bin], psiMinShare)
+		contribution := (current - baseline) * math.Log(current/baseline)
+		psi += contribution
+		shifts = append(shifts, BinShift{Bin: bin, Baseline: baselineShares[bin], Current: currentShares[bin], Contribution: contribution})
+	}
+	sort.Slice(shifts, func(i, j int) bool {
+		if shifts[i].Contribution != shifts[j].Contribution {
+			return shifts[i].Contribution > shifts[j].Contribution
+		}
+		return shifts[i].Bin < shifts[j].Bin
+	})
+	if len(shifts) > psiLoggedBins {
+		shifts = shifts[:psiLoggedBins]
+	}
+
+	result := psi <= maxPSI
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"psi":           psi,
+		"max_psi":       maxPSI,
+		"bin_count":     len(bins),
+		"shifted_bins":  shifts,
+		"data_path":     dataPath,
+		"baseline_path": baselinePath,
+	}
+	if err := c.logResult("population_stability_index_below", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// trimCharacters are the whitespace characters IsColumnTrimmed expects no value to start or end with
+const trimCharacters = " \t\r\n"
+
+// IsColumnTrimmed checks if non-null values in a column have no leading or trailing whitespace.
+// Messy exports often carry stray spaces ('ACME ' vs 'ACME') that silently break joins and group-bys.
+// It counts values that differ from trim(value, trimCharacters), so spaces, tabs, and line breaks are all caught.
+func (c *DataQualityChecker) IsColumnTrimmed(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	value := fmt.Sprintf("CAST(%s AS VARCHAR)", quoteIdent(columnName))
+	subQuery := fmt.Sprintf("SELECT %s FROM %s WHERE %s != trim(%s, %s)",
+		quoteIdent(columnName), c.scanRows(dataPath), value, value, quoteLiteral(trimCharacters))
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM (%s)", subQuery)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_trimmed", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnNotBlank checks if a column has no NULL, empty, or whitespace-only values. It is stricter than
+// IsColumnNotNull, which passes empty and whitespace-only strings, because many teams treat a blank string as
+// just as missing.
+// Blank means empty after trim(value, trimCharacters); NULL and blank rows are both logged, under the check type
+// is_column_not_null_or_blank so the history shows it is not the plain not-null check.
+func (c *DataQualityChecker) IsColumnNotBlank(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT COUNT(*) FILTER (WHERE %s IS NULL), COUNT(*) FILTER (WHERE trim(CAST(%s AS VARCHAR), %s) = '') FROM %s",
+		quoteIdent(columnName), quoteIdent(columnName), quoteLiteral(trimCharacters), c.scanRows(dataPath))
+
+	var nullCount, blankCount int64
+	err = duckInfo.QueryRow(query).Scan(&nullCount, &blankCount)
+	if err != nil {
+		return false, err
+	}
+
+	errorCount := nullCount + blankCount
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+		"null_count":  nullCount,
+		"blank_count": blankCount,
+	}
+	if err := c.logResult("is_column_not_null_or_blank", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// editDistance returns the Levenshtein distance between a and b, counting runes rather than bytes
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// IsColumnEnumWithSuggestions checks the column against the allowed values like IsColumnEnum, and for each invalid
+// value suggests the closest allowed one ("did you mean?"), so a failure also says how to fix it.
+// The maxLoggedOffenders most frequent invalid values are compared to every allowed value by edit distance; the
+// nearest within maxEditDistance is suggested, the earliest in allowed winning ties. Values with no allowed value
+// that close get no suggestion. The check still fails on any invalid value; suggestions are logged as
+// invalid value -> suggested value.
+func (c *DataQualityChecker) IsColumnEnumWithSuggestions(dataPath, columnName string, allowed []string, maxEditDistance int) (bool, error) {
+	start := time.Now()
+	if maxEditDistance < 0 {
+		return false, fmt.Errorf("max edit distance must not be negative, got %d", maxEditDistance)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	value := fmt.Sprintf("CAST(%s AS VARCHAR)", quoteIdent(columnName))
+	query := fmt.Sprintf(`
+		SELECT %s AS value, COUNT(*) AS n, SUM(COUNT(*)) OVER ()
+		FROM %s WHERE %s NOT IN (%s) AND %s IS NOT NULL
+		GROUP BY value ORDER BY n DESC, value`,
+		value, c.scanRows(dataPath), value, quoteLiterals(allowed), quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var errorCount int64
+	var invalid []string
+	for rows.Next() {
+		var v string
+		var count int64
+		if err := rows.Scan(&v, &count, &errorCount); err != nil {
+			return false, err
+		}
+		if len(invalid) < maxLoggedOffenders {
+			invalid = append(invalid, v)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	suggestions := make(map[string]string)
+	for _, v := range invalid {
+		best, bestDistance := "", maxEditDistance+1
+		for _, candidate := range allowed {
+			if d := editDistance(v, candidate); d < bestDistance {
+				best, bestDistance = candidate, d
+			}
+		}
+		if best != "" {
+			suggestions[v] = best
+		}
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":            columnName,
+		"enum_values":       allowed,
+		"max_edit_distance": maxEditDistance,
+		"data_path":         dataPath,
+		"error_count":       errorCount,
+		"invalid_values":    invalid,
+		"suggestions":       suggestions,
+	}
+	if err := c.logResult("is_column_enum_with_suggestions", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// WithinRollingBounds checks that no value deviates from the mean of the windowSize rows before it by more than
+// maxDeviation. Global bounds miss local anomalies: a reading of 60 can be unremarkable overall yet a spike in a
+// run of 20s. Rows are ordered by orderBy (falling back to file order, as in IsColumnMonotonic) and the rolling
+// mean is AVG over the window ROWS BETWEEN windowSize PRECEDING AND 1 PRECEDING, so a row is never compared with
+// itself. Rows are only checked once their window holds windowSize non-null values, and NULL values are skipped.
+// The number of deviating rows and the largest deviation seen are logged.
+func (c *DataQualityChecker) WithinRollingBounds(dataPath, valueCol, orderBy string, windowSize int, maxDeviation float64) (bool, error) {
+	start := time.Now()
+	if windowSize < 1 {
+		return false, fmt.Errorf("window size must be at least 1, got %d", windowSize)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	windowOrder := "file_row"
+	if orderBy != "" {
+		windowOrder = quoteIdent(orderBy) + ", file_row"
+	}
+	window := fmt.Sprintf("(ORDER BY %s ROWS BETWEEN %d PRECEDING AND 1 PRECEDING)", windowOrder, windowSize)
+
+	query := fmt.Sprintf(`
+		WITH rolling AS (
+			SELECT %s AS val, AVG(%s) OVER %s AS rolling_mean, COUNT(%s) OVER %s AS window_count
+			FROM (SELECT *, row_number() OVER () AS file_row FROM %s)
+		), deviations AS (
+			SELECT abs(val - rolling_mean) AS deviation FROM rolling WHERE val IS NOT NULL AND window_count = %d
+		)
+		SELECT COUNT(*) FILTER (WHERE deviation > %f), COALESCE(MAX(deviation), 0) FROM deviations
+	`, quoteIdent(valueCol), quoteIdent(valueCol), window, quoteIdent(valueCol), window,
+		c.scanRows(dataPath), windowSize, maxDeviation)
+
+	var errorCount int64
+	var largestDeviation float64
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &largestDeviation)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":            valueCol,
+		"order_by":          orderBy,
+		"window_size":       windowSize,
+		"max_deviation":     maxDeviation,
+		"data_path":         dataPath,
+		"error_count":       errorCount,
+		"largest_deviation": largestDeviation,
+	}
+	if err := c.logResult("within_rolling_bounds", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// DistributionIsBalanced checks that every distinct value of columnName holds roughly an equal share of the rows,
+// e.g. that an A/B test assigns each variant about half of the users. Each value's share of the non-null rows is
+// compared with the uniform expectation 1/k for k distinct values, and the check fails if any deviates by more than
+// maxImbalance. The number of imbalanced values is logged as error_count, along with the most imbalanced value.
+func (c *DataQualityChecker) DistributionIsBalanced(dataPath, columnName string, maxImbalance float64) (bool, error) {
+	start := time.Now()
+	if maxImbalance < 0 || maxImbalance > 1 {
+		return false, fmt.Errorf("max imbalance must be within [0, 1], got %v", maxImbalance)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf(`
+		WITH shares AS (
+			SELECT CAST(%s AS VARCHAR) AS value, COUNT(*) / SUM(COUNT(*)) OVER () AS share, 1 / COUNT(*) OVER () AS expected
+			FROM %s WHERE %s IS NOT NULL GROUP BY 1
+		)
+		SELECT value, share, expected, COUNT(*) FILTER (WHERE abs(share - expected) > %f) OVER () AS imbalanced
+		FROM shares ORDER BY abs(share - expected) DESC, value LIMIT 1
+	`, quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName), maxImbalance)
+
+	var mostImbalanced sql.NullString
+	var share, expected float64
+	var errorCount int64
+	err = duckInfo.QueryRow(query).Scan(&mostImbalanced, &share, &expected, &errorCount)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":         columnName,
+		"max_imbalance":  maxImbalance,
+		"data_path":      dataPath,
+		"error_count":    errorCount,
+		"expected_share": expected,
+	}
+	if mostImbalanced.Valid {
+		params["most_imbalanced_value"] = mostImbalanced.String
+		params["most_imbalanced_share"] = share
+	}
+	if err := c.logResult("distribution_is_balanced", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// schemaDifferences describes how a file's columns differ from a reference file's, one entry per kind of
+// difference (e.g. "extra columns: discount"), or nil when names, order and types all match
+func schemaDifferences(reference, actual []typedColumn) []string {
+	names := func(cols []typedColumn) []string {
+		out := make([]string, len(cols))
+		for i, col := range cols {
+			out[i] = col.Name
+		}
+		return out
+	}
+	missing, extra, outOfOrder := compareColumnLists(names(reference), names(actual))
+
+	referenceTypes := make(map[string]string, len(reference))
+	for _, col := range reference {
+		referenceTypes[col.Name] = col.Type
+	}
+	var typeMismatches []string
+	for _, col := range actual {
+		if referenceType, ok := referenceTypes[col.Name]; ok && referenceType != col.Type {
+			typeMismatches = append(typeMismatches, fmt.Sprintf("%s (%s, expected %s)", col.Name, col.Type, referenceType))
+		}
+	}
+
+	var differences []string
+	for _, diff := range []struct {
+		label   string
+		columns []string
+	}{
+		{"missing columns", missing},
+		{"extra columns", extra},
+		{"type mismatches", typeMismatches},
+		{"out of order columns", outOfOrder},
+	} {
+		if len(diff.columns) > 0 {
+			differences = append(differences, diff.label+": "+strings.Join(diff.columns, ", "))
+		}
+	}
+	return differences
+}
+
+// GlobSchemaConsistent checks that every file matched by globPath has the same schema as the first one, in file
+// name order. Reading a glob as one table fails (or silently widens types) when a single file drifts, so each file
+// is DESCRIBEd on its own. Divergent files are logged with how they differ; each counts as one error.
+func (c *DataQualityChecker) GlobSchemaConsistent(globPath string) (bool, error) {
+	start := time.Now()
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+	if isRemotePath(globPath) {
+		if err := c.loadHTTPFS(duckInfo); err != nil {
+			return false, err
+		}
+	}
+
+	rows, err := duckInfo.Query("SELECT file FROM glob(?) ORDER BY file", globPath)
+	if err != nil {
+		return false, err
+	}
+	var files []string
+	for rows.Next() {
+		var file string
+		if err := rows.Scan(&file); err != nil {
+			rows.Close()
+			return false, err
+		}
+		files = append(files, file)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+	if len(files) == 0 {
+		return false, fmt.Errorf("no files match %s", globPath)
+	}
+
+	reference, err := c.describeColumnTypes(duckInfo, files[0])
+	if err != nil {
+		return false, fmt.Errorf("failed to describe %s: %w", files[0], err)
+	}
+	divergent := make(map[string][]string)
+	for _, file := range files[1:] {
+		actual, err := c.describeColumnTypes(duckInfo, file)
+		if err != nil {
+			return false, fmt.Errorf("failed to describe %s: %w", file, err)
+		}
+		if differences := schemaDifferences(reference, actual); differences != nil {
+			divergent[file] = differences
+		}
+	}
+
+	errorCount := int64(len(divergent))
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"data_path":       globPath,
+		"file_count":      len(files),
+		"reference_file":  files[0],
+		"error_count":     errorCount,
+		"divergent_files": divergent,
+	}
+	if err := c.logResult("glob_schema_consistent", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnMatchesGrammar checks that every non-null value of columnName is a sentence of the grammar in
+// grammarPath (see Grammar), for structured codes a regex cannot describe, such as nested brackets.
+// Each distinct value is parsed once in Go; failing rows are counted and offending values are logged (capped).
+func (c *DataQualityChecker) IsColumnMatchesGrammar(dataPath, columnName, grammarPath string) (bool, error) {
+	start := time.Now()
+	grammar, err := LoadGrammar(grammarPath)
+	if err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT CAST(%s AS VARCHAR), COUNT(*) FROM %s WHERE %s IS NOT NULL GROUP BY 1",
+		quoteIdent(columnName), c.scanRowsAsText(dataPath, columnName), quoteIdent(columnName))
+	rows, err := duckInfo.Query(query)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+
+	var errorCount int64
+	var offenders []string
+	for rows.Next() {
+		var value string
+		var count int64
+		if err := rows.Scan(&value, &count); err != nil {
+			return false, err
+		}
+		if !grammar.Matches(value) {
+			errorCount += count
+			if len(offenders) < maxLoggedOffenders {
+				offenders = append(offenders, value)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":       columnName,
+		"grammar_path": grammarPath,
+		"data_path":    dataPath,
+		"error_count":  errorCount,
+		"offenders":    offenders,
+	}
+	if err := c.logResult("is_column_matches_grammar", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// Check is one weighted entry of a data quality score: Run performs the check and Weight is its share of the score
+type Check struct {
+	Name   string
+	Weight float64
+	Run    func() (bool, error)
+}
+
+// ScoreContribution records how one check contributed to a score: the points it earned out of the points it was
+// worth (its weight as a share of 100), and the error that kept it from running, if any
+type ScoreContribution struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+	Passed bool    `json:"passed"`
+	Points float64 `json:"points"`
+	Worth  float64 `json:"worth"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// Score runs the checks against dataPath and returns a 0-100 data quality score: the weighted share of checks
+// that passed. A check that errors counts as failed rather than aborting the score, so one unreadable
+// column lowers the number instead of hiding it. The score and each check's contribution are logged; with no
+// threshold to meet, the logged result passes only when every check passed.
+func (c *DataQualityChecker) Score(dataPath string, checks []Check) (float64, error) {
+	start := time.Now()
+	score, contributions, err := scoreChecks(checks)
+	if err != nil {
+		return 0, err
+	}
+
+	params := scoreParams(dataPath, score, contributions)
+	result := params["error_count"] == int64(0)
+	if err := c.logResult("data_quality_score", result, params, start); err != nil {
+		return score, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return score, nil
+}
+
+// scoreChecks runs the checks and computes the weighted pass ratio along with each check's contribution
+func scoreChecks(checks []Check) (float64, []ScoreContribution, error) {
+	if len(checks) == 0 {
+		return 0, nil, fmt.Errorf("score needs at least one check")
+	}
+	var totalWeight float64
+	for _, check := range checks {
+		if check.Weight <= 0 {
+			return 0, nil, fmt.Errorf("check %s: weight must be positive, got %v", check.Name, check.Weight)
+		}
+		totalWeight += check.Weight
+	}
+
+	var score float64
+	contributions := make([]ScoreContribution, len(checks))
+	for i, check := range checks {
+		passed, err := check.Run()
+		contribution := ScoreContribution{Name: check.Name, Weight: check.Weight, Worth: 100 * check.Weight / totalWeight}
+		if err != nil {
+			contribution.Error = err.Error()
+		} else if passed {
+			contribution.Passed = true
+			contribution.Points = contribution.Worth
+		}
+		score += contribution.Points
+		contributions[i] = contribution
+	}
+	return score, contributions, nil
+}
+
+// scoreParams builds the logged params of a score; error_count is the number of checks that failed or errored
+func scoreParams(dataPath string, score float64, contributions []ScoreContribution) map[string]interface{} {
+	var errorCount int64
+	for _, contribution := range contributions {
+		if !contribution.Passed {
+			errorCount++
+		}
+	}
+	return map[string]interface{}{
+		"data_path":     dataPath,
+		"score":         score,
+		"error_count":   errorCount,
+		"contributions": contributions,
+	}
+}
+
+// IsScoreAtLeast computes the data quality score of the checks (see Score) and passes when it is at least
+// minScore. The score and each check's contribution are logged; error_count is the number of checks that
+// failed or errored.
+func (c *DataQualityChecker) IsScoreAtLeast(dataPath string, checks []Check, minScore float64) (bool, error) {
+	start := time.Now()
+	if minScore < 0 || minScore > 100 {
+		return false, fmt.Errorf("min score must be within [0, 100], got %v", minScore)
+	}
+	score, contributions, err := scoreChecks(checks)
+	if err != nil {
+		return false, err
+	}
+
+	result := score >= minScore
+
+	params := scoreParams(dataPath, score, contributions)
+	params["min_score"] = minScore
+	if err := c.logResult("data_quality_score", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnSubsetOfReference checks that every non-null value of column in the data file appears in
+// referenceColumn of the reference file, e.g. that every sku sold is in the catalog. Unlike
+// AreTablesReferentialIntegral it matches a single column against the reference's distinct values, so the
+// columns may have different names and duplicate reference rows cannot multiply the join.
+// Orphan rows are counted as error_count, and the number of distinct orphan values and a capped sample are logged.
+func (c *DataQualityChecker) IsColumnSubsetOfReference(dataPath, column, referencePath, referenceColumn string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if err := c.validatePathExists(referencePath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	orphans := fmt.Sprintf(`
+		SELECT d.%s AS value
+		FROM %s d
+		LEFT JOIN (SELECT DISTINCT %s AS value FROM %s WHERE %s IS NOT NULL) r ON d.%s = r.value
+		WHERE d.%s IS NOT NULL AND r.value IS NULL
+	`, quoteIdent(column), c.scanRows(dataPath), quoteIdent(referenceColumn), c.scan(referencePath),
+		quoteIdent(referenceColumn), quoteIdent(column), quoteIdent(column))
+
+	var errorCount, orphanValues int64
+	query := fmt.Sprintf("SELECT COUNT(*), COUNT(DISTINCT value) FROM (%s)", orphans)
+	if err := duckInfo.QueryRow(query).Scan(&errorCount, &orphanValues); err != nil {
+		return false, err
+	}
+
+	var offenders []string
+	if errorCount > 0 {
+		sampleQuery := fmt.Sprintf("SELECT DISTINCT CAST(value AS VARCHAR) AS v FROM (%s) ORDER BY v LIMIT %d", orphans, maxLoggedOffenders)
+		rows, err := duckInfo.Query(sampleQuery)
+		if err != nil {
+			return false, err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var value string
+			if err := rows.Scan(&value); err != nil {
+				return false, err
+			}
+			offenders = append(offenders, value)
+		}
+		if err := rows.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":           column,
+		"reference_column": referenceColumn,
+		"data_path":        dataPath,
+		"reference_path":   referencePath,
+		"error_count":      errorCount,
+		"orphan_values":    orphanValues,
+	}
+	if len(offenders) > 0 {
+		params["offenders"] = offenders
+	}
+	if err := c.logResult("is_column_subset_of_reference", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnWithinZScore checks that no value of columnName lies more than maxAbsZ sample standard deviations
+// from the column mean. Unlike a fixed IsColumnBetween range, the bounds adapt to the data.
+// A column whose values are all identical (or that has fewer than two values) has no spread to measure and
+// passes. The number of outliers is logged as error_count, along with the mean and standard deviation.
+func (c *DataQualityChecker) IsColumnWithinZScore(dataPath, columnName string, maxAbsZ float64) (bool, error) {
+	start := time.Now()
+	if maxAbsZ <= 0 {
+		return false, fmt.Errorf("max z-score must be positive, got %v", maxAbsZ)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	scan := c.scanRows(dataPath)
+	query := fmt.Sprintf(`
+		WITH stats AS (SELECT avg(%s) AS mean, stddev_samp(%s) AS stddev FROM %s)
+		SELECT
+			COUNT(*) FILTER (WHERE stddev > 0 AND abs((%s - mean) / stddev) > %f),
+			any_value(mean), any_value(stddev)
+		FROM %s CROSS JOIN stats
+	`, col, col, scan, col, maxAbsZ, scan)
+
+	var errorCount int64
+	var mean, stddev sql.NullFloat64
+	if err := duckInfo.QueryRow(query).Scan(&errorCount, &mean, &stddev); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"max_abs_z":   maxAbsZ,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+		"mean":        mean.Float64,
+		"stddev":      stddev.Float64,
+	}
+	if err := c.logResult("is_column_within_z_score", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnWithinIQR checks that every value of columnName lies within [Q1 - k*IQR, Q3 + k*IQR], where Q1 and Q3
+// are the interpolated quartiles (quantile_cont) and IQR = Q3 - Q1. The quartiles are insensitive to the
+// outliers themselves, so this is more robust than IsColumnWithinZScore on skewed data; k = 1.5 gives Tukey's fences.
+func (c *DataQualityChecker) IsColumnWithinIQR(dataPath, columnName string, k float64) (bool, error) {
+	start := time.Now()
+	if k < 0 {
+		return false, fmt.Errorf("IQR multiplier k must not be negative, got %v", k)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	scan := c.scanRows(dataPath)
+	query := fmt.Sprintf(`
+		WITH quartiles AS (
+			SELECT quantile_cont(%s, 0.25) AS q1, quantile_cont(%s, 0.75) AS q3 FROM %s
+		), fences AS (
+			SELECT q1, q3, q1 - %f * (q3 - q1) AS lower_fence, q3 + %f * (q3 - q1) AS upper_fence FROM quartiles
+		)
+		SELECT
+			COUNT(*) FILTER (WHERE %s < lower_fence OR %s > upper_fence),
+			any_value(q1), any_value(q3), any_value(lower_fence), any_value(upper_fence)
+		FROM %s CROSS JOIN fences
+	`, col, col, scan, k, k, col, col, scan)
+
+	var errorCount int64
+	var q1, q3, lowerFence, upperFence sql.NullFloat64
+	if err := duckInfo.QueryRow(query).Scan(&errorCount, &q1, &q3, &lowerFence, &upperFence); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"k":           k,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+		"q1":          q1.Float64,
+		"q3":          q3.Float64,
+		"lower_fence": lowerFence.Float64,
+		"upper_fence": upperFence.Float64,
+	}
+	if err := c.logResult("is_column_within_iqr", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// AreColumnsMutuallyExclusive checks that no row populates both col1 and col2, for either/or fields such as
+// home_phone and mobile_phone. Rows where either column is null pass.
+func (c *DataQualityChecker) AreColumnsMutuallyExclusive(dataPath, col1, col2 string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND %s IS NOT NULL",
+		c.scanRows(dataPath), quoteIdent(col1), quoteIdent(col2))
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column1":     col1,
+		"column2":     col2,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("are_columns_mutually_exclusive", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsAtLeastOneNotNull checks that every row populates at least one of columns, e.g. a contact must have an
+// email, a phone or an address. Rows where all the columns are null fail.
+func (c *DataQualityChecker) IsAtLeastOneNotNull(dataPath string, columns []string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+	if len(columns) == 0 {
+		return false, fmt.Errorf("at least one column is required")
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	conditions := make([]string, len(columns))
+	for i, col := range columns {
+		conditions[i] = quoteIdent(col) + " IS NULL"
+	}
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", c.scanRows(dataPath), strings.Join(conditions, " AND "))
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"columns":     columns,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_at_least_one_not_null", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnValidJSON checks that every non-null value of columnName parses as JSON, using DuckDB's json_valid.
+// Columns holding serialized documents are read as text, so a truncated or hand-edited value would otherwise go
+// unnoticed until a consumer tries to parse it.
+func (c *DataQualityChecker) IsColumnValidJSON(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND NOT json_valid(CAST(%s AS VARCHAR))",
+		c.scanRows(dataPath), quoteIdent(columnName), quoteIdent(columnName))
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_valid_json", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnIsWholeNumber checks that every non-null value of columnName has no fractional part (col = floor(col)).
+// Unlike IsColumnOfType with INTEGER, it accepts whole values stored as floats or decimals, such as 3.0, so it
+// checks what the values are rather than how they are stored.
+func (c *DataQualityChecker) IsColumnIsWholeNumber(dataPath, columnName string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND %s != floor(%s)",
+		c.scanRows(dataPath), col, col, col)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_whole_number", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnScaleAtMost checks that no non-null value of columnName has more than maxScale fractional digits,
+// e.g. amounts with at most 2 decimal places. CSV columns are read as written, and trailing zeros do not count,
+// so 1.500 has a scale of 1 just as it would in a DECIMAL column.
+func (c *DataQualityChecker) IsColumnScaleAtMost(dataPath, columnName string, maxScale int) (bool, error) {
+	start := time.Now()
+	if maxScale < 0 {
+		return false, fmt.Errorf("max scale must not be negative, got %d", maxScale)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	scale := fmt.Sprintf(`length(rtrim(regexp_extract(trim(CAST(%s AS VARCHAR)), '\.([0-9]*)', 1), '0'))`, col)
+	query := fmt.Sprintf("SELECT COUNT(*) FILTER (WHERE %s > %d), COALESCE(MAX(%s), 0) FROM %s WHERE %s IS NOT NULL",
+		scale, maxScale, scale, c.scanRowsAsText(dataPath, columnName), col)
+
+	var errorCount, largestScale int64
+	err = duckInfo.QueryRow(query).Scan(&errorCount, &largestScale)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":        columnName,
+		"max_scale":     maxScale,
+		"data_path":     dataPath,
+		"error_count":   errorCount,
+		"largest_scale": largestScale,
+	}
+	if err := c.logResult("is_column_scale_at_most", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnPrefixed checks that every non-null value of columnName starts with prefix, e.g. SKUs starting with "SKU-".
+// It compares with starts_with rather than LIKE, so % and _ in the prefix match literally. CSV columns are read as
+// text, so a prefix such as "00" is not lost to a sniffed numeric type.
+func (c *DataQualityChecker) IsColumnPrefixed(dataPath, columnName, prefix string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND NOT starts_with(CAST(%s AS VARCHAR), %s)",
+		c.scanRowsAsText(dataPath, columnName), col, col, quoteLiteral(prefix))
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"prefix":      prefix,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_prefixed", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnSuffixed checks that every non-null value of columnName ends with suffix, e.g. file names ending in
+// ".csv" or hosts ending in ".example.com". Like IsColumnPrefixed, it compares with ends_with, so the suffix
+// matches literally.
+func (c *DataQualityChecker) IsColumnSuffixed(dataPath, columnName, suffix string) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND NOT ends_with(CAST(%s AS VARCHAR), %s)",
+		c.scanRowsAsText(dataPath, columnName), col, col, quoteLiteral(suffix))
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"suffix":      suffix,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult("is_column_suffixed", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnContains checks that every non-null value of columnName contains substr, e.g. a required watermark or
+// marker, without writing a regex. The substring matches literally.
+func (c *DataQualityChecker) IsColumnContains(dataPath, columnName, substr string) (bool, error) {
+	return c.columnContains(dataPath, columnName, substr, false)
+}
+
+// IsColumnNotContains is the negation of IsColumnContains: it checks that no value contains substr, for scanning
+// text for forbidden tokens.
+func (c *DataQualityChecker) IsColumnNotContains(dataPath, columnName, substr string) (bool, error) {
+	return c.columnContains(dataPath, columnName, substr, true)
+}
+
+// columnContains counts the non-null values that lack substr, or with negate those that contain it
+func (c *DataQualityChecker) columnContains(dataPath, columnName, substr string, negate bool) (bool, error) {
+	start := time.Now()
+	if substr == "" {
+		return false, fmt.Errorf("substring must not be empty")
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	condition := fmt.Sprintf("contains(CAST(%s AS VARCHAR), %s)", col, quoteLiteral(substr))
+	checkType := "is_column_not_contains"
+	if !negate {
+		condition = "NOT " + condition
+		checkType = "is_column_contains"
+	}
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s IS NOT NULL AND %s",
+		c.scanRowsAsText(dataPath, columnName), col, condition)
+
+	var errorCount int64
+	err = duckInfo.QueryRow(countQuery).Scan(&errorCount)
+	if err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"substring":   substr,
+		"negate":      negate,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+	}
+	if err := c.logResult(checkType, result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnDateBetween checks that every non-null value of columnName is a date within [minDate, maxDate], given as
+// ISO dates (YYYY-MM-DD). It is the date counterpart of the numeric IsColumnBetween. Values are TRY_CAST to DATE,
+// and those that fail to parse are counted separately (unparseable_count) from parsed dates outside the range
+// (out_of_range_count); both fail the check.
+func (c *DataQualityChecker) IsColumnDateBetween(dataPath, columnName, minDate, maxDate string) (bool, error) {
+	start := time.Now()
+	minDay, err := time.Parse("2006-01-02", minDate)
+	if err != nil {
+		return false, fmt.Errorf("invalid min date %q: expected YYYY-MM-DD", minDate)
+	}
+	maxDay, err := time.Parse("2006-01-02", maxDate)
+	if err != nil {
+		return false, fmt.Errorf("invalid max date %q: expected YYYY-MM-DD", maxDate)
+	}
+	if minDay.After(maxDay) {
+		return false, fmt.Errorf("min date %s is after max date %s", minDate, maxDate)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	col := quoteIdent(columnName)
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(*) FILTER (WHERE day IS NULL),
+			COUNT(*) FILTER (WHERE day < DATE %s OR day > DATE %s)
+		FROM (SELECT TRY_CAST(%s AS DATE) AS day FROM %s WHERE %s IS NOT NULL)
+	`, quoteLiteral(minDate), quoteLiteral(maxDate), col, c.scanRows(dataPath), col)
+
+	var unparseableCount, outOfRangeCount int64
+	if err := duckInfo.QueryRow(query).Scan(&unparseableCount, &outOfRangeCount); err != nil {
+		return false, err
+	}
+
+	errorCount := unparseableCount + outOfRangeCount
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":             columnName,
+		"min_date":           minDate,
+		"max_date":           maxDate,
+		"data_path":          dataPath,
+		"error_count":        errorCount,
+		"unparseable_count":  unparseableCount,
+		"out_of_range_count": outOfRangeCount,
+	}
+	if err := c.logResult("is_column_date_between", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsColumnDateNotInFuture checks that no date or timestamp value of columnName lies after the current time,
+// catching clock and parsing bugs that leak future dates into event data. It is IsColumnDateNotInFutureAsOf
+// with the reference time set to now.
+func (c *DataQualityChecker) IsColumnDateNotInFuture(dataPath, columnName string) (bool, error) {
+	return c.IsColumnDateNotInFutureAsOf(dataPath, columnName, time.Now())
+}
+
+// IsColumnDateNotInFutureAsOf checks that no value of columnName lies after asOf, pinning the reference time so
+// that runs are reproducible. Values are TRY_CAST to TIMESTAMP, so a date counts as its midnight and is in the
+// future only from the following day; values that do not parse are left to IsColumnDateParseable.
+// Timestamps without a time zone are compared with asOf's wall-clock time.
+func (c *DataQualityChecker) IsColumnDateNotInFutureAsOf(dataPath, columnName string, asOf time.Time) (bool, error) {
+	start := time.Now()
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	reference := asOf.Format("2006-01-02 15:04:05.999999")
+	query := fmt.Sprintf(`
+		SELECT COUNT(*) FILTER (WHERE ts > TIMESTAMP %s), CAST(MAX(ts) AS VARCHAR)
+		FROM (SELECT TRY_CAST(%s AS TIMESTAMP) AS ts FROM %s WHERE %s IS NOT NULL)
+	`, quoteLiteral(reference), quoteIdent(columnName), c.scanRows(dataPath), quoteIdent(columnName))
+
+	var errorCount int64
+	var latest sql.NullString
+	if err := duckInfo.QueryRow(query).Scan(&errorCount, &latest); err != nil {
+		return false, err
+	}
+
+	result := errorCount == 0
+
+	params := map[string]interface{}{
+		"column":      columnName,
+		"as_of":       reference,
+		"data_path":   dataPath,
+		"error_count": errorCount,
+		"latest":      latest.String,
+	}
+	if err := c.logResult("is_column_date_not_in_future", result, params, start); err != nil {
+		return result, fmt.Errorf("failed to log result: %w", err)
+	}
+
+	return result, nil
+}
+
+// IsDataFresh checks that the latest value of timestampColumn is no older than maxAge, to detect stale feeds.
+// It is IsDataFreshAsOf with the reference time set to now.
+func (c *DataQualityChecker) IsDataFresh(dataPath, timestampColumn string, maxAge time.Duration) (bool, error) {
+	return c.IsDataFreshAsOf(dataPath, timestampColumn, maxAge, time.Now())
+}
+
+// IsDataFreshAsOf checks that max(timestampColumn) lies within maxAge before asOf. A column with no parseable
+// timestamps fails, since nothing shows the feed has delivered. Like IsColumnDateNotInFutureAsOf, timestamps
+// without a time zone are compared with asOf's wall-clock time. The latest timestamp and its age are logged.
+func (c *DataQualityChecker) IsDataFreshAsOf(dataPath, timestampColumn string, maxAge time.Duration, asOf time.Time) (bool, error) {
+	start := time.Now()
+	if maxAge <= 0 {
+		return false, fmt.Errorf("max age must be positive, got %v", maxAge)
+	}
+	if err := c.validatePathExists(dataPath); err != nil {
+		return false, err
+	}
+
+	duckInfo, err := c.getDuckDB()
+	if err != nil {
+		return false, err
+	}
+
+	query := fmt.Sprintf("SELECT MAX(TRY_CAST(%s AS TIMESTAMP)) FROM %s", quoteIdent(timestampColumn), c.scanRows(dataPath))
+
+	var latest sql.NullTime
+	if err := duckInfo.QueryRow(query).Scan(&latest); err != nil {
+		return false, err
+	}
+
+	// DuckDB returns zoneless timestamps as UTC, so read asOf's wall clock as UTC too
+	reference := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), asOf.Hour(), asOf.Minute(), asOf.Second(), asOf.Nanosecond(), time.UTC)
+	params := map[string]interface{}{
+		"column":    timestampColumn,
+		"max_age":   maxAge.String(),
+		"as_of":     reference.Format("2006-01-02 15:04:05"),
+		"data_path": dataPath,
+	}
+
+	result := false
+	if latest.Valid {
+		age := reference.Sub(latest.Time)
+		result = age <= maxAge
+		params["latest"] = latest.Time.Format("2006-01-02 15:04:05")
+		params["age"] = age.String()
+		params["age_seconds"] = age.Seconds()
+	}
+	if err := c.logResult("is_data_fresh", result, params, start); err != nil {
 		return result, fmt.Errorf("failed to log result: %w", err)
 	}
 
@@ -2,9 +2,12 @@ package checker
 
 import (
 	"database/sql"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/josephmachado/data_quality_checker/internal/db"
 	_ "github.com/mattn/go-sqlite3"
@@ -36,6 +39,7 @@ func setup(t *testing.T) (*DataQualityChecker, string) {
 	dbPath := filepath.Join(tempDir, "test.db")
 	connector := db.NewDBConnector(dbPath)
 	checker := NewDataQualityChecker(connector)
+	t.Cleanup(func() { checker.Close() })
 
 	return checker, dbPath
 }
@@ -65,6 +69,28 @@ func TestDataQualityChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("AreColumnsUnique", func(t *testing.T) {
+		path := writeTempCSV(t, "order_id,line_number,sku\n1,1,a\n1,2,b\n2,1,a")
+		v, err := checker.AreColumnsUnique(path, []string{"order_id", "line_number"})
+		if err != nil {
+			t.Fatalf("AreColumnsUnique failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected (order_id, line_number) to be unique")
+		}
+
+		v, _ = checker.AreColumnsUnique(path, []string{"order_id"})
+		if v {
+			t.Error("Expected order_id alone to NOT be unique")
+		}
+
+		path = writeTempCSV(t, "order_id,line_number\n1,1\n1,1")
+		v, _ = checker.AreColumnsUnique(path, []string{"order_id", "line_number"})
+		if v {
+			t.Error("Expected duplicate (order_id, line_number) pair to fail")
+		}
+	})
+
 	t.Run("IsColumnNotNull", func(t *testing.T) {
 		// Pass
 		path := getTestDataPath(t, "no_nulls.csv")
@@ -87,6 +113,35 @@ func TestDataQualityChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("ToleranceChecks", func(t *testing.T) {
+		// 1 null out of 4 rows = 25%
+		path := writeTempCSV(t, "name,age\nAlice,20\n,30\nBob,40\nCarol,99")
+		v, err := checker.IsColumnNotNullWithTolerance(path, "name", 0.25)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected 25% nulls to pass a 0.25 tolerance")
+		}
+		v, _ = checker.IsColumnNotNullWithTolerance(path, "name", 0.2)
+		if v {
+			t.Error("Expected 25% nulls to fail a 0.2 tolerance")
+		}
+
+		v, _ = checker.IsColumnBetweenWithTolerance(path, "age", 18, 50, 0.25)
+		if !v {
+			t.Error("Expected 1 of 4 out-of-range values to pass a 0.25 tolerance")
+		}
+		v, _ = checker.IsColumnBetweenWithTolerance(path, "age", 18, 50, 0.1)
+		if v {
+			t.Error("Expected 1 of 4 out-of-range values to fail a 0.1 tolerance")
+		}
+
+		if _, err := checker.IsColumnNotNullWithTolerance(path, "name", 1.5); err == nil {
+			t.Error("Expected error for tolerance outside [0, 1]")
+		}
+	})
+
 	t.Run("IsColumnEnum", func(t *testing.T) {
 		enumValues := []string{"active", "inactive", "pending"}
 
@@ -136,6 +191,67 @@ func TestDataQualityChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("AreTablesReferentialIntegralEmptyAsNull", func(t *testing.T) {
+		joinKeys := []string{"customer_id"}
+		dir := t.TempDir()
+		orders := filepath.Join(dir, "orders.json")
+		customers := filepath.Join(dir, "customers.json")
+		if err := os.WriteFile(orders, []byte(`{"order_id": 1, "customer_id": "c1"}
+{"order_id": 2, "customer_id": ""}
+{"order_id": 3, "customer_id": "c9"}
+`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(customers, []byte(`{"customer_id": "c1", "name": "Alice"}
+{"customer_id": "", "name": "Placeholder"}
+`), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		valid, err := checker.AreTablesReferentialIntegralEmptyAsNull(orders, customers, joinKeys, false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if valid {
+			t.Error("Expected the c9 orphan to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected the empty key to match the placeholder without the option, leaving 1 orphan, got %d", count)
+		}
+
+		// With the option the empty key is excluded: it neither matches the placeholder nor counts as an orphan
+		valid, err = checker.AreTablesReferentialIntegralEmptyAsNull(orders, customers, joinKeys, true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if valid {
+			t.Error("Expected the c9 orphan to still fail with empty-as-null")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected only the c9 orphan to be counted, got %d", count)
+		}
+
+		emptyOnly := filepath.Join(dir, "empty_only.json")
+		if err := os.WriteFile(emptyOnly, []byte(`{"order_id": 1, "customer_id": "c1"}
+{"order_id": 2, "customer_id": ""}
+`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		valid, err = checker.AreTablesReferentialIntegralEmptyAsNull(emptyOnly, orders, joinKeys, true)
+		if err != nil || !valid {
+			t.Errorf("Expected empty keys not to raise the error count, got %v (err: %v)", valid, err)
+		}
+		if count, _ := checker.LastErrorCount(); count != 0 {
+			t.Errorf("Expected no orphans, got %d", count)
+		}
+
+		numeric := writeTempCSV(t, "order_id,user_id\n1,1\n")
+		valid, err = checker.AreTablesReferentialIntegralEmptyAsNull(numeric, getTestDataPath(t, "users.csv"), []string{"user_id"}, true)
+		if err != nil || !valid {
+			t.Errorf("Expected numeric keys to join unchanged, got %v (err: %v)", valid, err)
+		}
+	})
+
 	t.Run("IsColumnInData", func(t *testing.T) {
 		path := getTestDataPath(t, "users.csv")
 
@@ -172,6 +288,32 @@ func TestDataQualityChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("IsColumnBetweenDetailed", func(t *testing.T) {
+		path := writeTempCSV(t, "id,age\n1,20\n2,5\n3,99\n4,30")
+
+		res, err := checker.IsColumnBetweenDetailed(path, "age", 18, 50)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if res.Passed {
+			t.Error("Expected out-of-range ages to fail")
+		}
+		if res.ErrorCount != 2 {
+			t.Errorf("Expected 2 failing rows, got %d", res.ErrorCount)
+		}
+		if len(res.Samples) != 2 {
+			t.Fatalf("Expected 2 samples, got %d", len(res.Samples))
+		}
+		if _, ok := res.Samples[0]["id"]; !ok {
+			t.Error("Expected samples to include all columns of the failing row")
+		}
+
+		res, _ = checker.IsColumnBetweenDetailed(path, "age", 0, 100)
+		if !res.Passed || res.ErrorCount != 0 || len(res.Samples) != 0 {
+			t.Errorf("Expected passing result without samples, got %+v", res)
+		}
+	})
+
 	t.Run("IsColumnRegexMatch", func(t *testing.T) {
 		path := writeTempCSV(t, "email\na@b.com\nc@d.com")
 
@@ -200,6 +342,28 @@ func TestDataQualityChecks(t *testing.T) {
 		}
 	})
 
+	t.Run("IsColumnNumericTyped", func(t *testing.T) {
+		path := writeTempCSV(t, "val\n1\n2.5\n3")
+		valid, err := checker.IsColumnNumericTyped(path, "val")
+		if err != nil {
+			t.Fatalf("IsColumnNumericTyped failed: %v", err)
+		}
+		if !valid {
+			t.Error("Expected true for a numeric column")
+		}
+
+		// A single stray value forces VARCHAR inference for the whole column
+		path = writeTempCSV(t, "val\n1\n2\nn/a\n4")
+		valid, _ = checker.IsColumnNumericTyped(path, "val")
+		if valid {
+			t.Error("Expected false for a column inferred as VARCHAR")
+		}
+
+		if _, err := checker.IsColumnNumericTyped(path, "missing"); err == nil {
+			t.Error("Expected error for a missing column")
+		}
+	})
+
 	t.Run("IsColumnLengthBetween", func(t *testing.T) {
 		path := writeTempCSV(t, "name\nAlice\nBob")
 		valid, _ := checker.IsColumnLengthBetween(path, "name", 3, 5)
@@ -232,6 +396,30 @@ func TestDataQualityChecks(t *testing.T) {
 		if !v {
 			t.Error("Median failed")
 		}
+		v, _ = checker.IsColumnSumBetween(path, "val", 55, 65)
+		if !v {
+			t.Error("Sum failed")
+		}
+		v, _ = checker.IsColumnSumBetween(path, "val", 0, 50)
+		if v {
+			t.Error("Sum should have failed")
+		}
+		v, _ = checker.IsColumnStdDevBetween(path, "val", 9, 11)
+		if !v {
+			t.Error("StdDev failed")
+		}
+		v, _ = checker.IsColumnQuantileBetween(path, "val", 0.95, 28, 30)
+		if !v {
+			t.Error("Quantile failed")
+		}
+		if _, err := checker.IsColumnQuantileBetween(path, "val", 1.5, 0, 100); err == nil {
+			t.Error("Expected error for quantile outside [0, 1]")
+		}
+
+		singleRow := writeTempCSV(t, "val\n10")
+		if _, err := checker.IsColumnStdDevBetween(singleRow, "val", 0, 1); err == nil {
+			t.Error("Expected error for stddev of a single row")
+		}
 	})
 
 	t.Run("TableLevelChecks", func(t *testing.T) {
@@ -272,6 +460,46 @@ func TestDataQualityChecks(t *testing.T) {
 			t.Error("Increasing failed")
 		}
 
+		// Rows are shuffled on disk: only ordering by ts exposes the increasing sequence
+		path = writeTempCSV(t, "ts,val\n3,30\n1,10\n4,40\n2,20")
+		v, err := checker.IsColumnIncreasingBy(path, "val", "ts")
+		if err != nil {
+			t.Fatalf("IsColumnIncreasingBy failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected values increasing when ordered by ts")
+		}
+		v, _ = checker.IsColumnIncreasing(path, "val")
+		if v {
+			t.Error("Expected shuffled file order to NOT be increasing")
+		}
+
+		path = writeTempCSV(t, "val\n10\n7\n7\n1")
+		v, _ = checker.IsColumnDecreasing(path, "val")
+		if v {
+			t.Error("Expected repeated value to fail strictly decreasing")
+		}
+		v, err = checker.IsColumnMonotonic(path, "val", "decreasing", false, "")
+		if err != nil {
+			t.Fatalf("IsColumnMonotonic failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected non-increasing values to pass non-strict decreasing")
+		}
+		v, _ = checker.IsColumnMonotonic(path, "val", "increasing", false, "")
+		if v {
+			t.Error("Expected decreasing values to fail non-strict increasing")
+		}
+
+		path = writeTempCSV(t, "val\n1\n1\n2")
+		v, _ = checker.IsColumnMonotonic(path, "val", "increasing", false, "")
+		if !v {
+			t.Error("Expected non-decreasing values to pass non-strict increasing")
+		}
+		if _, err := checker.IsColumnMonotonic(path, "val", "sideways", true, ""); err == nil {
+			t.Error("Expected error for unsupported direction")
+		}
+
 		path = writeTempCSV(t, "dt\n2023-01-01\n2023-05-01")
 		v, _ = checker.IsColumnDateParseable(path, "dt")
 		if !v {
@@ -296,31 +524,2332 @@ func TestDataQualityChecks(t *testing.T) {
 			t.Error("Pair equal should have failed")
 		}
 	})
-}
 
-func TestLogsAreWritten(t *testing.T) {
-	checker, dbPath := setup(t)
-	path := getTestDataPath(t, "unique_data.csv")
+	t.Run("GroupSumMatchesReference", func(t *testing.T) {
+		refPath := writeTempCSV(t, "account,total\nA,100\nB,50")
 
-	_, err := checker.IsColumnUnique(path, "id")
-	if err != nil {
-		t.Fatal(err)
-	}
+		path := writeTempCSV(t, "account,amount\nA,60\nA,40\nB,50")
+		v, err := checker.GroupSumMatchesReference(path, "amount", []string{"account"}, refPath, "account", "total", 0.01)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected allocations to reconcile to reference totals")
+		}
 
-	// Connect to DB and check logs
-	sqliteDB, err := sql.Open("sqlite3", dbPath)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer sqliteDB.Close()
+		// Account B allocations sum to 45 instead of 50
+		path = writeTempCSV(t, "account,amount\nA,60\nA,40\nB,30\nB,15")
+		v, err = checker.GroupSumMatchesReference(path, "amount", []string{"account"}, refPath, "account", "total", 0.01)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v {
+			t.Error("Expected reconciliation to fail for account B")
+		}
+	})
 
-	var count int
-	err = sqliteDB.QueryRow("SELECT COUNT(*) FROM log").Scan(&count)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 1 {
-		t.Errorf("Expected 1 log entry, got %d", count)
+	t.Run("IsColumnValidChecksum", func(t *testing.T) {
+		path := writeTempCSV(t, "id\n2363\n2364")
+		v, err := checker.IsColumnValidChecksum(path, "id", "verhoeff")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v {
+			t.Error("Expected 2364 to fail verhoeff")
+		}
+
+		path = writeTempCSV(t, "id\n2363\n")
+		v, _ = checker.IsColumnValidChecksum(path, "id", "verhoeff")
+		if !v {
+			t.Error("Expected 2363 to pass verhoeff")
+		}
+
+		path = writeTempCSV(t, "id\n79927398713")
+		v, _ = checker.IsColumnValidChecksum(path, "id", "luhn")
+		if !v {
+			t.Error("Expected 79927398713 to pass luhn")
+		}
+
+		if _, err := checker.IsColumnValidChecksum(path, "id", "crc32"); err == nil {
+			t.Error("Expected error for unsupported algorithm")
+		}
+	})
+
+	t.Run("DistinctCountStable", func(t *testing.T) {
+		baselinePath := writeTempCSV(t, "category\na\nb\nc\nd")
+
+		// Distinct count halved from 4 to 2: exactly at the 0.5 floor
+		path := writeTempCSV(t, "category\na\na\nb\nb")
+		v, err := checker.DistinctCountStable(path, baselinePath, "category", 0.5)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected ratio 0.5 to pass a 0.5 floor")
+		}
+
+		// Just below the floor
+		v, _ = checker.DistinctCountStable(path, baselinePath, "category", 0.51)
+		if v {
+			t.Error("Expected ratio 0.5 to fail a 0.51 floor")
+		}
+
+		path = writeTempCSV(t, "category\na\na\na")
+		v, _ = checker.DistinctCountStable(path, baselinePath, "category", 0.5)
+		if v {
+			t.Error("Expected collapsed distinct count to fail")
+		}
+	})
+
+	t.Run("ListElementsBetween", func(t *testing.T) {
+		path := writeTempCSV(t, "scores\n\"10,20,30\"\n\"0,100\"")
+		v, err := checker.ListElementsBetween(path, "scores", ",", 0, 100)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected all list elements within [0, 100]")
+		}
+
+		path = writeTempCSV(t, "scores\n\"10,20,30\"\n\"10,200,30\"")
+		v, _ = checker.ListElementsBetween(path, "scores", ",", 0, 100)
+		if v {
+			t.Error("Expected 10,200,30 to fail a max of 100")
+		}
+	})
+
+	t.Run("IsColumnValidGitSHA", func(t *testing.T) {
+		path := writeTempCSV(t, "sha\n3f786850e387550fdab836ed7e6dc881de23001b")
+		v, err := checker.IsColumnValidGitSHA(path, "sha", true)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected valid 40-char SHA to pass")
+		}
+
+		path = writeTempCSV(t, "sha\n3f786850e387550fdab836ed7e6dc881de23001b\n3f786850e387550fdab836ed7e6dc881de23001z")
+		v, _ = checker.IsColumnValidGitSHA(path, "sha", true)
+		if v {
+			t.Error("Expected SHA containing 'z' to fail")
+		}
+
+		path = writeTempCSV(t, "sha\n3f78685")
+		v, _ = checker.IsColumnValidGitSHA(path, "sha", false)
+		if !v {
+			t.Error("Expected valid 7-char SHA to pass")
+		}
+	})
+
+	t.Run("VersionRangesContiguous", func(t *testing.T) {
+		path := writeTempCSV(t, "id,valid_from,valid_to\n1,2023-01-01,2023-02-01\n1,2023-02-01,2023-03-01\n2,2023-01-01,2023-06-01")
+		v, err := checker.VersionRangesContiguous(path, "id", "valid_from", "valid_to")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected contiguous version ranges to pass")
+		}
+
+		// Entity 1 has a gap between 2023-02-01 and 2023-02-15
+		path = writeTempCSV(t, "id,valid_from,valid_to\n1,2023-01-01,2023-02-01\n1,2023-02-15,2023-03-01")
+		v, _ = checker.VersionRangesContiguous(path, "id", "valid_from", "valid_to")
+		if v {
+			t.Error("Expected gap between versions to fail")
+		}
+	})
+
+	t.Run("QuotedIdentifiers", func(t *testing.T) {
+		// Reserved words and names with spaces or quotes must not break the generated SQL
+		path := writeTempCSV(t, "select,order date\nAlice,2023-01-01\nBob,2023-01-02")
+		v, err := checker.IsColumnNotNull(path, "select")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected column 'select' to have no nulls")
+		}
+
+		v, err = checker.IsColumnUnique(path, "order date")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected column 'order date' to be unique")
+		}
+
+		v, err = checker.IsColumnInData(path, `id"; DROP TABLE log; --`)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if v {
+			t.Error("Expected injected column name to NOT exist")
+		}
+	})
+
+	t.Run("IsColumnJSONSafe", func(t *testing.T) {
+		path := writeTempCSV(t, "note\nhello\n\"quoted \"\"text\"\"\"\nüñïçødé")
+		v, err := checker.IsColumnJSONSafe(path, "note")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected plain text to be JSON-safe")
+		}
+
+		path = writeTempCSV(t, "note\nhello\nbell\x07char")
+		v, _ = checker.IsColumnJSONSafe(path, "note")
+		if v {
+			t.Error("Expected raw control character to fail")
+		}
+	})
+
+	t.Run("IsColumnValidJSONPointer", func(t *testing.T) {
+		path := writeTempCSV(t, "ptr\n/foo/0\n/a~1b/c~0d\n/")
+		v, err := checker.IsColumnValidJSONPointer(path, "ptr")
+		if err != nil {
+			t.Fatalf("IsColumnValidJSONPointer failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected valid JSON pointers to pass")
+		}
+
+		path = writeTempCSV(t, "ptr\n/foo/0\nfoo/0")
+		v, _ = checker.IsColumnValidJSONPointer(path, "ptr")
+		if v {
+			t.Error("Expected pointer without leading slash to fail")
+		}
+
+		path = writeTempCSV(t, "ptr\n/foo~2bar")
+		v, _ = checker.IsColumnValidJSONPointer(path, "ptr")
+		if v {
+			t.Error("Expected invalid ~ escape to fail")
+		}
+	})
+
+	t.Run("HasNoDuplicateColumns", func(t *testing.T) {
+		path := writeTempCSV(t, "id,name,email\n1,Alice,a@b.com")
+		v, err := checker.HasNoDuplicateColumns(path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected distinct header names to pass")
+		}
+
+		path = writeTempCSV(t, "id,name,id\n1,Alice,2")
+		v, _ = checker.HasNoDuplicateColumns(path)
+		if v {
+			t.Error("Expected repeated header 'id' to fail")
+		}
+	})
+
+	t.Run("IsColumnSignificantFiguresAtMost", func(t *testing.T) {
+		path := writeTempCSV(t, "reading\n1.234\n0.00120\n4500")
+		v, err := checker.IsColumnSignificantFiguresAtMost(path, "reading", 4)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected values within 4 significant figures to pass")
+		}
+
+		path = writeTempCSV(t, "reading\n1.234\n1.23456")
+		v, _ = checker.IsColumnSignificantFiguresAtMost(path, "reading", 4)
+		if v {
+			t.Error("Expected 1.23456 to fail a max of 4 significant figures")
+		}
+	})
+
+	t.Run("AllPathsExist", func(t *testing.T) {
+		existing := writeTempCSV(t, "x\n1")
+		missing := filepath.Join(t.TempDir(), "missing.csv")
+
+		path := writeTempCSV(t, "file\n"+existing+"\nhttps://example.com/skipped.csv")
+		v, err := checker.AllPathsExist(path, "file", false)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected existing paths to pass")
+		}
+
+		path = writeTempCSV(t, "file\n"+existing+"\n"+missing)
+		v, _ = checker.AllPathsExist(path, "file", false)
+		if v {
+			t.Error("Expected manifest with a missing file to fail")
+		}
+	})
+
+	t.Run("ConditionalEnum", func(t *testing.T) {
+		mapping := map[string][]string{
+			"fruit":     {"apple", "banana"},
+			"vegetable": {"carrot", "leek"},
+		}
+
+		path := writeTempCSV(t, "type,subtype\nfruit,apple\nvegetable,carrot\nfruit,")
+		v, err := checker.ConditionalEnum(path, "type", "subtype", mapping)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !v {
+			t.Error("Expected subtypes valid for their type to pass")
+		}
+
+		// carrot is valid for vegetable but appears under fruit
+		path = writeTempCSV(t, "type,subtype\nfruit,apple\nfruit,carrot")
+		v, _ = checker.ConditionalEnum(path, "type", "subtype", mapping)
+		if v {
+			t.Error("Expected carrot under fruit to fail")
+		}
+	})
+
+	t.Run("LastResultParams", func(t *testing.T) {
+		path := writeTempCSV(t, "id\n1\n1\n2\n2\n3")
+		if _, err := checker.IsColumnUnique(path, "id"); err != nil {
+			t.Fatal(err)
+		}
+		if params := checker.LastResultParams(); params["data_path"] != path {
+			t.Errorf("Expected last params to describe the latest check, got %v", params)
+		}
+		count, ok := checker.LastErrorCount()
+		if !ok || count != 2 {
+			t.Errorf("Expected last error count 2, got %d (ok=%v)", count, ok)
+		}
+	})
+
+	t.Run("RowChurnBelow", func(t *testing.T) {
+		before := writeTempCSV(t, "id,name,score\n1,a,10\n2,b,20\n3,c,30\n4,d,40")
+		after := writeTempCSV(t, "id,name,score\n1,a,10\n2,b,25\n3,x,30\n4,d,40\n5,e,50")
+
+		// 2 of the 4 matched rows changed: 50% churn
+		v, err := checker.RowChurnBelow(before, after, "id", 0.3)
+		if err != nil {
+			t.Fatalf("RowChurnBelow failed: %v", err)
+		}
+		if v {
+			t.Error("Expected 50% churn to fail a 0.3 threshold")
+		}
+
+		v, _ = checker.RowChurnBelow(before, after, "id", 0.5)
+		if !v {
+			t.Error("Expected 50% churn to pass a 0.5 threshold")
+		}
+
+		v, _ = checker.RowChurnBelow(before, before, "id", 0)
+		if !v {
+			t.Error("Expected identical snapshots to have no churn")
+		}
+	})
+
+	t.Run("AvgWordCountBetween", func(t *testing.T) {
+		path := writeTempCSV(t, "review\n\"great product, works well\"\n\"  too   short  \"\nok\n")
+		// word counts 4, 2, 1 -> average 2.33
+		v, err := checker.AvgWordCountBetween(path, "review", 2, 3)
+		if err != nil {
+			t.Fatalf("AvgWordCountBetween failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected average word count within [2, 3]")
+		}
+
+		v, _ = checker.AvgWordCountBetween(path, "review", 5, 500)
+		if v {
+			t.Error("Expected average word count below 5 to fail")
+		}
+	})
+
+	t.Run("IsColumnInAllocationRange", func(t *testing.T) {
+		ranges := map[string][2]int64{"crm": {1, 999}, "web": {1000, 1999}}
+		path := writeTempCSV(t, "id,source\n5,crm\n998,crm\n1000,web\n1500,web")
+		v, err := checker.IsColumnInAllocationRange(path, "id", "source", ranges)
+		if err != nil {
+			t.Fatalf("IsColumnInAllocationRange failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected all IDs inside their source's block")
+		}
+
+		// 1200 belongs to the web block but is attributed to crm
+		path = writeTempCSV(t, "id,source\n5,crm\n1200,crm\n1500,web")
+		v, _ = checker.IsColumnInAllocationRange(path, "id", "source", ranges)
+		if v {
+			t.Error("Expected ID from another source's block to fail")
+		}
+
+		path = writeTempCSV(t, "id,source\n5,batch")
+		v, _ = checker.IsColumnInAllocationRange(path, "id", "source", ranges)
+		if v {
+			t.Error("Expected source without a block to fail")
+		}
+	})
+
+	t.Run("AllRowsSatisfy", func(t *testing.T) {
+		path := writeTempCSV(t, "status,qty\nshipped,2\npending,0\n")
+		v, err := checker.AllRowsSatisfy(path, `"qty" > 0 OR "status" = 'pending'`)
+		if err != nil {
+			t.Fatalf("AllRowsSatisfy failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected every row to satisfy the predicate")
+		}
+
+		v, _ = checker.AllRowsSatisfy(path, `"qty" > 0`)
+		if v {
+			t.Error("Expected a row with qty 0 to fail")
+		}
+
+		// A NULL predicate result counts as a failure
+		path = writeTempCSV(t, "status,qty\nshipped,\n")
+		v, _ = checker.AllRowsSatisfy(path, `"qty" > 0`)
+		if v {
+			t.Error("Expected NULL predicate result to fail")
+		}
+	})
+
+	t.Run("IsColumnDistinctCountBetween", func(t *testing.T) {
+		path := writeTempCSV(t, "country\nUS\nUS\nFR\nDE\n\n")
+		v, err := checker.IsColumnDistinctCountBetween(path, "country", 1, 3)
+		if err != nil {
+			t.Fatalf("IsColumnDistinctCountBetween failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected 3 distinct values within [1, 3]")
+		}
+
+		v, _ = checker.IsColumnDistinctCountBetween(path, "country", 4, 200)
+		if v {
+			t.Error("Expected 3 distinct values to fail [4, 200]")
+		}
+	})
+
+	t.Run("IsColumnDateGranularity", func(t *testing.T) {
+		path := writeTempCSV(t, "period\n2024-01-01\n2024-02-01\n2024-03-01")
+		v, err := checker.IsColumnDateGranularity(path, "period", "month")
+		if err != nil {
+			t.Fatalf("IsColumnDateGranularity failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected first-of-month dates to pass month granularity")
+		}
+
+		path = writeTempCSV(t, "period\n2024-01-01\n2024-02-15")
+		v, _ = checker.IsColumnDateGranularity(path, "period", "month")
+		if v {
+			t.Error("Expected mid-month date to fail month granularity")
+		}
+		v, _ = checker.IsColumnDateGranularity(path, "period", "day")
+		if !v {
+			t.Error("Expected plain dates to pass day granularity")
+		}
+
+		path = writeTempCSV(t, "ts\n2024-01-01 00:00:00\n2024-01-02 13:30:00")
+		v, _ = checker.IsColumnDateGranularity(path, "ts", "day")
+		if v {
+			t.Error("Expected timestamp with nonzero time to fail day granularity")
+		}
+
+		if _, err := checker.IsColumnDateGranularity(path, "ts", "fortnight"); err == nil {
+			t.Error("Expected error for unsupported granularity")
+		}
+	})
+
+	t.Run("ValueRateNear", func(t *testing.T) {
+		// 2 fraud rows out of 100
+		content := "is_fraud\n" + strings.Repeat("true\n", 2) + strings.Repeat("false\n", 98)
+		path := writeTempCSV(t, content)
+		v, err := checker.ValueRateNear(path, "is_fraud", "true", 0.02, 0.005)
+		if err != nil {
+			t.Fatalf("ValueRateNear failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected 2% rate to be near 2%")
+		}
+
+		// The rate drifts to 5%
+		content = "is_fraud\n" + strings.Repeat("true\n", 5) + strings.Repeat("false\n", 95)
+		path = writeTempCSV(t, content)
+		v, _ = checker.ValueRateNear(path, "is_fraud", "true", 0.02, 0.005)
+		if v {
+			t.Error("Expected 5% rate to fall outside 2% ± 0.5%")
+		}
+	})
+
+	t.Run("IsColumnNullFractionBelow", func(t *testing.T) {
+		// 1 missing email out of 20 is exactly 5%
+		content := "id,email\n1,\n" + strings.Repeat("2,a@example.com\n", 19)
+		path := writeTempCSV(t, content)
+		v, err := checker.IsColumnNullFractionBelow(path, "email", 0.05)
+		if err != nil {
+			t.Fatalf("IsColumnNullFractionBelow failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected 5% nulls to be within a 5% budget")
+		}
+
+		v, _ = checker.IsColumnNullFractionBelow(path, "email", 0.01)
+		if v {
+			t.Error("Expected 5% nulls to exceed a 1% budget")
+		}
+
+		// Header-only file has no rows to compute a fraction over
+		path = writeTempCSV(t, "id,email\n")
+		if _, err := checker.IsColumnNullFractionBelow(path, "email", 0.05); err == nil {
+			t.Error("Expected error for empty file")
+		}
+	})
+
+	t.Run("ReferenceAttributeNotNull", func(t *testing.T) {
+		customers := writeTempCSV(t, "customer_id,region\n1,EU\n2,\n3,US\n")
+
+		// Orders for customers 1 and 3 (and an unmatched 9) reference customers with a region
+		orders := writeTempCSV(t, "order_id,customer_id\n10,1\n11,3\n12,9\n")
+		v, err := checker.ReferenceAttributeNotNull(orders, "customer_id", customers, "customer_id", "region")
+		if err != nil {
+			t.Fatalf("ReferenceAttributeNotNull failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected matched customers with regions to pass")
+		}
+
+		// Customer 2 exists but has no region
+		orders = writeTempCSV(t, "order_id,customer_id\n10,1\n11,2\n")
+		v, _ = checker.ReferenceAttributeNotNull(orders, "customer_id", customers, "customer_id", "region")
+		if v {
+			t.Error("Expected order referencing a customer with a null region to fail")
+		}
+	})
+
+	t.Run("ColumnSumsTo", func(t *testing.T) {
+		path := writeTempCSV(t, "channel,pct\nweb,60.0\napp,30.0\nstore,10.0\n")
+		v, err := checker.ColumnSumsTo(path, "pct", 100, 0.1)
+		if err != nil {
+			t.Fatalf("ColumnSumsTo failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected shares summing to 100 to pass")
+		}
+
+		// Shares sum to 99.5
+		path = writeTempCSV(t, "channel,pct\nweb,60.0\napp,29.5\nstore,10.0\n")
+		v, _ = checker.ColumnSumsTo(path, "pct", 100, 0.1)
+		if v {
+			t.Error("Expected shares summing to 99.5 to fail a 0.1 tolerance")
+		}
+	})
+
+	t.Run("NoLongRunsOfIdenticalValues", func(t *testing.T) {
+		// A run of 15 identical readings, written out of timestamp order
+		var sb strings.Builder
+		sb.WriteString("ts,reading\n")
+		for i := 20; i >= 1; i-- {
+			reading := "21.5"
+			if i <= 3 || i > 18 {
+				reading = fmt.Sprintf("%d.0", i)
+			}
+			sb.WriteString(fmt.Sprintf("%d,%s\n", i, reading))
+		}
+		path := writeTempCSV(t, sb.String())
+
+		v, err := checker.NoLongRunsOfIdenticalValues(path, "reading", "ts", 10)
+		if err != nil {
+			t.Fatalf("NoLongRunsOfIdenticalValues failed: %v", err)
+		}
+		if v {
+			t.Error("Expected a run of 15 identical readings to fail a max run of 10")
+		}
+		if got := checker.LastResultParams()["longest_run"]; got != int64(15) {
+			t.Errorf("Expected longest_run 15, got %v", got)
+		}
+
+		v, _ = checker.NoLongRunsOfIdenticalValues(path, "reading", "ts", 15)
+		if !v {
+			t.Error("Expected a run of 15 to pass a max run of 15")
+		}
+	})
+
+	t.Run("FilesAreIdentical", func(t *testing.T) {
+		expected := writeTempCSV(t, "id,name,amount\n1,Alice,10\n2,Bob,20\n3,Cara,30\n")
+		// Same rows in a different order
+		actual := writeTempCSV(t, "id,name,amount\n3,Cara,30\n1,Alice,10\n2,Bob,20\n")
+		for _, keys := range [][]string{{"id"}, nil} {
+			v, err := checker.FilesAreIdentical(actual, expected, keys)
+			if err != nil {
+				t.Fatalf("FilesAreIdentical failed: %v", err)
+			}
+			if !v {
+				t.Errorf("Expected identical files to pass with keys %v", keys)
+			}
+		}
+
+		// Bob's amount differs
+		actual = writeTempCSV(t, "id,name,amount\n1,Alice,10\n2,Bob,25\n3,Cara,30\n")
+		v, err := checker.FilesAreIdentical(actual, expected, []string{"id"})
+		if err != nil {
+			t.Fatalf("FilesAreIdentical failed: %v", err)
+		}
+		if v {
+			t.Error("Expected a differing row to fail")
+		}
+		params := checker.LastResultParams()
+		if params["rows_differing"] != int64(1) || params["rows_only_in_data"] != int64(0) || params["rows_only_in_other"] != int64(0) {
+			t.Errorf("Expected exactly one differing row, got %v", params)
+		}
+
+		// Without keys the changed row is reported on both sides
+		v, _ = checker.FilesAreIdentical(actual, expected, nil)
+		if v {
+			t.Error("Expected a differing row to fail without keys")
+		}
+		if got := checker.LastResultParams()["error_count"]; got != int64(2) {
+			t.Errorf("Expected error_count 2 without keys, got %v", got)
+		}
+
+		// Disjoint columns leave nothing to compare without keys; the check fails on the column lists alone
+		disjoint := writeTempCSV(t, "sku,price\nA1,9.99\n")
+		v, err = checker.FilesAreIdentical(disjoint, expected, nil)
+		if err != nil {
+			t.Fatalf("FilesAreIdentical with disjoint columns failed: %v", err)
+		}
+		if v {
+			t.Error("Expected files with disjoint columns to fail")
+		}
+		params = checker.LastResultParams()
+		if fmt.Sprint(params["columns_only_in_data"]) != "[sku price]" || fmt.Sprint(params["columns_only_in_other"]) != "[id name amount]" {
+			t.Errorf("Expected both column lists to be logged, got %v", params)
+		}
+	})
+
+	t.Run("JSONInput", func(t *testing.T) {
+		dir := t.TempDir()
+		content := "{\"id\": 1, \"name\": \"Alice\"}\n{\"id\": 2, \"name\": null}\n"
+		ndjson := filepath.Join(dir, "users.ndjson")
+		ambiguous := filepath.Join(dir, "users.log")
+		for _, path := range []string{ndjson, ambiguous} {
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// .ndjson is detected from the extension
+		v, err := checker.IsColumnUnique(ndjson, "id")
+		if err != nil {
+			t.Fatalf("IsColumnUnique on NDJSON failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected NDJSON ids to be unique")
+		}
+		v, _ = checker.IsColumnNotNull(ndjson, "name")
+		if v {
+			t.Error("Expected NDJSON null name to fail")
+		}
+
+		// Other extensions need an explicit format
+		if err := checker.SetScanOptions(ScanOptions{Format: "json"}); err != nil {
+			t.Fatal(err)
+		}
+		defer checker.SetScanOptions(ScanOptions{})
+		v, err = checker.IsColumnUnique(ambiguous, "id")
+		if err != nil {
+			t.Fatalf("IsColumnUnique with json format failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected ids to be unique when read as JSON")
+		}
+
+		if err := checker.SetScanOptions(ScanOptions{Format: "xml"}); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+	})
+
+	t.Run("HasNoNearZeroValues", func(t *testing.T) {
+		path := writeTempCSV(t, "reading\n12.5\n0\n-3.2\n\n")
+		v, err := checker.HasNoNearZeroValues(path, "reading", 0.001)
+		if err != nil {
+			t.Fatalf("HasNoNearZeroValues failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected exact zero and non-zero readings to pass")
+		}
+		v, _ = checker.HasNoNearZeroValuesIncludingZero(path, "reading", 0.001, true)
+		if v {
+			t.Error("Expected exact zero to fail when zeros are included")
+		}
+
+		path = writeTempCSV(t, "reading\n12.5\n0.0005\n-3.2\n")
+		v, _ = checker.HasNoNearZeroValues(path, "reading", 0.001)
+		if v {
+			t.Error("Expected 0.0005 to be flagged as near zero")
+		}
+	})
+
+	t.Run("CSVScanOptions", func(t *testing.T) {
+		if got, want := scanExpr("data.csv", ScanOptions{Delimiter: ";", NoHeader: true, NullString: "NA"}),
+			"read_csv('data.csv', delim=';', header=false, nullstr='NA')"; got != want {
+			t.Errorf("scanExpr = %q, want %q", got, want)
+		}
+		if got := scanExpr("data.parquet", ScanOptions{Delimiter: ";"}); got != "'data.parquet'" {
+			t.Errorf("Expected CSV options to leave parquet files alone, got %q", got)
+		}
+
+		path := writeTempCSV(t, "id;email\n1;a@example.com\n2;NA\n")
+		defer checker.SetScanOptions(ScanOptions{})
+		if err := checker.SetScanOptions(ScanOptions{Delimiter: ";", NullString: "NA"}); err != nil {
+			t.Fatal(err)
+		}
+		v, err := checker.IsColumnNotNull(path, "email")
+		if err != nil {
+			t.Fatalf("IsColumnNotNull with ';' delimiter failed: %v", err)
+		}
+		if v {
+			t.Error("Expected NA email to be read as NULL")
+		}
+
+		// Headerless files get DuckDB's generated column names
+		path = writeTempCSV(t, "1;a@example.com\n2;b@example.com\n")
+		if err := checker.SetScanOptions(ScanOptions{Delimiter: ";", NoHeader: true}); err != nil {
+			t.Fatal(err)
+		}
+		v, err = checker.IsColumnUnique(path, "column0")
+		if err != nil {
+			t.Fatalf("IsColumnUnique on headerless file failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected headerless ids to be unique")
+		}
+	})
+
+	t.Run("IsColumnValidEpoch", func(t *testing.T) {
+		// 2021-01-01 and 2024-06-01 in seconds and in milliseconds
+		seconds := writeTempCSV(t, "created_at\n1609459200\n1717200000\n\n")
+		millis := writeTempCSV(t, "created_at\n1609459200000\n1717200000000\n")
+
+		v, err := checker.IsColumnValidEpoch(millis, "created_at", "ms", 2000, 2030)
+		if err != nil {
+			t.Fatalf("IsColumnValidEpoch failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected millisecond epochs to fall within 2000-2030")
+		}
+
+		v, _ = checker.IsColumnValidEpoch(seconds, "created_at", "s", 2000, 2030)
+		if !v {
+			t.Error("Expected second epochs to fall within 2000-2030")
+		}
+
+		// Seconds read as millis land in January 1970
+		v, _ = checker.IsColumnValidEpoch(seconds, "created_at", "ms", 2000, 2030)
+		if v {
+			t.Error("Expected seconds-scale values to fail when read as milliseconds")
+		}
+
+		if _, err := checker.IsColumnValidEpoch(seconds, "created_at", "days", 2000, 2030); err == nil {
+			t.Error("Expected error for unsupported unit")
+		}
+	})
+
+	t.Run("HasNoDuplicateRows", func(t *testing.T) {
+		// Rows share ids and names but never every column
+		path := writeTempCSV(t, "id,name,amount\n1,Alice,10\n1,Alice,20\n2,Alice,10\n")
+		v, err := checker.HasNoDuplicateRows(path)
+		if err != nil {
+			t.Fatalf("HasNoDuplicateRows failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected partially overlapping rows to pass")
+		}
+
+		path = writeTempCSV(t, "id,name,amount\n1,Alice,10\n2,Bob,20\n1,Alice,10\n1,Alice,10\n")
+		v, _ = checker.HasNoDuplicateRows(path)
+		if v {
+			t.Error("Expected fully duplicated rows to fail")
+		}
+		params := checker.LastResultParams()
+		if params["error_count"] != int64(1) || params["duplicate_rows"] != int64(2) {
+			t.Errorf("Expected 1 duplicated group with 2 surplus rows, got %v", params)
+		}
+	})
+
+	t.Run("IsMonotonicAfterThreshold", func(t *testing.T) {
+		// Flat at 0 until activation, then strictly increasing
+		path := writeTempCSV(t, "day,usage\n1,0\n2,0\n3,0\n4,5\n5,9\n6,14\n")
+		v, err := checker.IsMonotonicAfterThreshold(path, "usage", "day", 0)
+		if err != nil {
+			t.Fatalf("IsMonotonicAfterThreshold failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected flat-then-increasing series to pass")
+		}
+		// The strict check would reject the flat prefix
+		if v, _ := checker.IsColumnIncreasingBy(path, "usage", "day"); v {
+			t.Error("Expected strict increasing check to fail on the flat prefix")
+		}
+
+		// One dip in the active region, written out of day order
+		path = writeTempCSV(t, "day,usage\n6,14\n1,0\n2,0\n4,5\n3,0\n5,4\n")
+		v, _ = checker.IsMonotonicAfterThreshold(path, "usage", "day", 0)
+		if v {
+			t.Error("Expected a dip after activation to fail")
+		}
+		if got := checker.LastResultParams()["error_count"]; got != int64(1) {
+			t.Errorf("Expected 1 violation in the active region, got %v", got)
+		}
+	})
+
+	t.Run("IsColumnComparison", func(t *testing.T) {
+		path := writeTempCSV(t, "start_date,end_date,list_price,discount_price\n2024-01-01,2024-01-05,10,8\n2024-02-01,2024-02-01,20,\n")
+		v, err := checker.IsColumnComparison(path, "end_date", ">=", "start_date")
+		if err != nil {
+			t.Fatalf("IsColumnComparison failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected end dates on or after start dates to pass")
+		}
+
+		v, _ = checker.IsColumnComparison(path, "end_date", ">", "start_date")
+		if v {
+			t.Error("Expected equal dates to fail a strict comparison")
+		}
+
+		// The NULL discount is not compared
+		v, _ = checker.IsColumnComparison(path, "discount_price", "<=", "list_price")
+		if !v {
+			t.Error("Expected discounts at or below list price to pass")
+		}
+
+		if _, err := checker.IsColumnComparison(path, "end_date", ">= start_date OR 1=1 --", "start_date"); err == nil {
+			t.Error("Expected error for operator outside the allow-list")
+		}
+	})
+
+	t.Run("HasWellFormedQuoting", func(t *testing.T) {
+		path := writeTempCSV(t, "id,comment\n1,\"says \"\"hi\"\", then leaves\"\n2,\"multi\nline\"\n3,plain\n")
+		v, err := checker.HasWellFormedQuoting(path)
+		if err != nil {
+			t.Fatalf("HasWellFormedQuoting failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected escaped and multi-line quoted fields to pass")
+		}
+
+		// The quote opened on line 3 is never closed
+		path = writeTempCSV(t, "id,comment\n1,fine\n2,\"unterminated\n3,swallowed\n4,also swallowed\n")
+		v, err = checker.HasWellFormedQuoting(path)
+		if err != nil {
+			t.Fatalf("HasWellFormedQuoting failed: %v", err)
+		}
+		if v {
+			t.Error("Expected an unterminated quoted field to fail")
+		}
+		if got := checker.LastResultParams()["first_offending_line"]; got != 3 {
+			t.Errorf("Expected first offending line 3, got %v", got)
+		}
+	})
+
+	t.Run("IsConditionTrue", func(t *testing.T) {
+		path := writeTempCSV(t, "status,shipped_at\nshipped,2024-01-02\npending,\ncancelled,\n")
+		v, err := checker.IsConditionTrue(path, "status = 'shipped'", "shipped_at IS NOT NULL")
+		if err != nil {
+			t.Fatalf("IsConditionTrue failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected shipped rows with a ship date to pass")
+		}
+
+		path = writeTempCSV(t, "status,shipped_at\nshipped,2024-01-02\nshipped,\npending,\n")
+		v, _ = checker.IsConditionTrue(path, "status = 'shipped'", "shipped_at IS NOT NULL")
+		if v {
+			t.Error("Expected a shipped row without a ship date to fail")
+		}
+	})
+
+	t.Run("RunRuleFile", func(t *testing.T) {
+		path := writeTempCSV(t, "id,amount,currency\n1,10,EUR\n2,-5,USD\n3,7,EUR\n")
+		rulesPath := filepath.Join(t.TempDir(), "rules.yaml")
+		rules := "currency is known: currency IN ('EUR', 'USD')\namount is positive: amount > 0\n"
+		if err := os.WriteFile(rulesPath, []byte(rules), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		results, err := checker.RunRuleFile(path, rulesPath)
+		if err != nil {
+			t.Fatalf("RunRuleFile failed: %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("Expected 2 rule results, got %d", len(results))
+		}
+		if results[0].Name != "currency is known" || !results[0].Passed {
+			t.Errorf("Expected first rule 'currency is known' to pass, got %+v", results[0])
+		}
+		if results[1].Name != "amount is positive" || results[1].Passed || results[1].ErrorCount != 1 {
+			t.Errorf("Expected 'amount is positive' to fail on 1 row, got %+v", results[1])
+		}
+
+		if err := os.WriteFile(rulesPath, []byte("- not a mapping\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := checker.RunRuleFile(path, rulesPath); err == nil {
+			t.Error("Expected error for a rules file that is not a mapping")
+		}
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		path := writeTempCSV(t, "region,email,amount\nEU,a@example.com,10\nUS,,\nEU,b@example.com,20\nUS,c@example.com,-5\n")
+		defer checker.SetScanOptions(ScanOptions{})
+
+		if err := checker.SetScanOptions(ScanOptions{Filter: "region = 'EU'"}); err != nil {
+			t.Fatal(err)
+		}
+		v, err := checker.IsColumnNotNull(path, "email")
+		if err != nil {
+			t.Fatalf("IsColumnNotNull with filter failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected EU rows to have emails")
+		}
+		v, _ = checker.IsColumnBetween(path, "amount", 0, 100)
+		if !v {
+			t.Error("Expected EU amounts to be within range")
+		}
+		v, _ = checker.IsTableRowCountBetween(path, 2, 2)
+		if !v {
+			t.Error("Expected the filter to scope the row count to 2 EU rows")
+		}
+
+		// An OR filter must not leak into the check's own conditions
+		if err := checker.SetScanOptions(ScanOptions{Filter: "region = 'EU' OR amount IS NULL"}); err != nil {
+			t.Fatal(err)
+		}
+		v, _ = checker.IsColumnNotNull(path, "email")
+		if v {
+			t.Error("Expected the US row without an email to fail once it is in scope")
+		}
+
+		if err := checker.SetScanOptions(ScanOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		v, _ = checker.IsColumnNotNull(path, "email")
+		if v {
+			t.Error("Expected the unfiltered check to fail")
+		}
+	})
+
+	t.Run("MatchesDDL", func(t *testing.T) {
+		path := writeTempCSV(t, "id,name,amount\n1,Alice,1.5\n2,Bob,2.25\n")
+
+		v, err := checker.MatchesDDL(path, `CREATE TABLE payments (
+			id BIGINT PRIMARY KEY,
+			"name" VARCHAR(50) NOT NULL,
+			amount DOUBLE DEFAULT 0
+		);`)
+		if err != nil {
+			t.Fatalf("MatchesDDL failed: %v", err)
+		}
+		if !v {
+			t.Errorf("Expected matching DDL to pass, got %v", checker.LastResultParams())
+		}
+
+		v, err = checker.MatchesDDL(path, `CREATE TABLE payments (id BIGINT, name VARCHAR, amount INTEGER, created_at DATE, PRIMARY KEY (id))`)
+		if err != nil {
+			t.Fatalf("MatchesDDL failed: %v", err)
+		}
+		if v {
+			t.Error("Expected DDL with a missing column and a type mismatch to fail")
+		}
+		params := checker.LastResultParams()
+		if missing := params["missing_columns"].([]string); len(missing) != 1 || missing[0] != "created_at" {
+			t.Errorf("Expected created_at to be missing, got %v", missing)
+		}
+		if mismatches := params["type_mismatches"].([]string); len(mismatches) != 1 || mismatches[0] != "amount: expected INTEGER, got DOUBLE" {
+			t.Errorf("Expected an amount type mismatch, got %v", mismatches)
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected 2 mismatches, got %d", count)
+		}
+
+		v, _ = checker.MatchesDDL(path, `CREATE TABLE payments (name VARCHAR, id BIGINT, amount DOUBLE)`)
+		if v {
+			t.Error("Expected DDL with a different column order to fail")
+		}
+		if order := checker.LastResultParams()["out_of_order_columns"].([]string); len(order) != 2 {
+			t.Errorf("Expected name and id to be out of order, got %v", order)
+		}
+
+		if _, err := checker.MatchesDDL(path, "SELECT 1"); err == nil {
+			t.Error("Expected error for a non-CREATE TABLE statement")
+		}
+		if _, err := checker.MatchesDDL(path, "CREATE TABLE t (id NOTATYPE)"); err == nil {
+			t.Error("Expected error for an unknown type")
+		}
+	})
+
+	t.Run("IsColumnValidEmail", func(t *testing.T) {
+		valid := writeTempCSV(t, "email\na@example.com\no'brien@example.co.uk\nfirst.last+tag@sub.example-mail.org\n\n")
+		v, err := checker.IsColumnValidEmail(valid, "email")
+		if err != nil {
+			t.Fatalf("IsColumnValidEmail failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected well-formed emails and NULLs to pass")
+		}
+
+		for _, bad := range []string{"not-an-email", "a@localhost", "a@@example.com", "a b@example.com", "a@-example.com", "@example.com"} {
+			path := writeTempCSV(t, fmt.Sprintf("email\na@example.com\n\"%s\"\n", bad))
+			v, err := checker.IsColumnValidEmail(path, "email")
+			if err != nil {
+				t.Fatalf("IsColumnValidEmail failed for %q: %v", bad, err)
+			}
+			if v {
+				t.Errorf("Expected %q to fail", bad)
+			}
+		}
+	})
+
+	t.Run("IsColumnEnumDetailed", func(t *testing.T) {
+		var rows strings.Builder
+		rows.WriteString("status\n")
+		for value, n := range map[string]int{"active": 4, "actve": 3, "DELETED": 1, "": 2} {
+			for i := 0; i < n; i++ {
+				rows.WriteString(value + "\n")
+			}
+		}
+		path := writeTempCSV(t, rows.String())
+
+		res, err := checker.IsColumnEnumDetailed(path, "status", []string{"active", "inactive"})
+		if err != nil {
+			t.Fatalf("IsColumnEnumDetailed failed: %v", err)
+		}
+		if res.Passed || res.ErrorCount != 4 {
+			t.Errorf("Expected 4 invalid rows, got %+v", res)
+		}
+		want := []ValueCount{{Value: "actve", Count: 3}, {Value: "DELETED", Count: 1}}
+		if len(res.Offenders) != len(want) {
+			t.Fatalf("Expected offenders %v, got %v", want, res.Offenders)
+		}
+		for i := range want {
+			if res.Offenders[i] != want[i] {
+				t.Errorf("Offender %d: expected %v, got %v", i, want[i], res.Offenders[i])
+			}
+		}
+		if _, ok := checker.LastResultParams()["offenders"]; !ok {
+			t.Error("Expected the histogram to be logged")
+		}
+
+		res, _ = checker.IsColumnEnumDetailed(path, "status", []string{"active", "actve", "DELETED"})
+		if !res.Passed || len(res.Offenders) != 0 {
+			t.Errorf("Expected passing result without offenders, got %+v", res)
+		}
+	})
+
+	t.Run("IsColumnValidUUID", func(t *testing.T) {
+		valid := writeTempCSV(t, "id,n\n123e4567-e89b-12d3-a456-426614174000,1\n123E4567-E89B-12D3-A456-426614174000,2\n,3\n")
+		v, err := checker.IsColumnValidUUID(valid, "id")
+		if err != nil {
+			t.Fatalf("IsColumnValidUUID failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected canonical UUIDs in either case to pass")
+		}
+
+		for _, bad := range []string{"123e4567e89b12d3a456426614174000", "{123e4567-e89b-12d3-a456-426614174000}", "123e4567-e89b-12d3-a456-42661417400", "g23e4567-e89b-12d3-a456-426614174000"} {
+			path := writeTempCSV(t, fmt.Sprintf("id\n123e4567-e89b-12d3-a456-426614174000\n%s\n", bad))
+			v, err := checker.IsColumnValidUUID(path, "id")
+			if err != nil {
+				t.Fatalf("IsColumnValidUUID failed for %q: %v", bad, err)
+			}
+			if v {
+				t.Errorf("Expected %q to fail", bad)
+			}
+		}
+	})
+
+	t.Run("NullRateStable", func(t *testing.T) {
+		withNulls := func(nulls int) string {
+			var rows strings.Builder
+			rows.WriteString("id,email\n")
+			for i := 0; i < 100; i++ {
+				if i < nulls {
+					fmt.Fprintf(&rows, "%d,\n", i)
+				} else {
+					fmt.Fprintf(&rows, "%d,user%d@example.com\n", i, i)
+				}
+			}
+			return writeTempCSV(t, rows.String())
+		}
+		baseline := withNulls(1)
+		current := withNulls(10)
+
+		v, err := checker.NullRateStable(current, baseline, "email", 0.05)
+		if err != nil {
+			t.Fatalf("NullRateStable failed: %v", err)
+		}
+		if v {
+			t.Error("Expected a jump from 1% to 10% nulls to fail with a 5 point allowance")
+		}
+		params := checker.LastResultParams()
+		if params["null_rate"] != 0.1 || params["baseline_null_rate"] != 0.01 {
+			t.Errorf("Expected rates 0.1 and 0.01, got %v and %v", params["null_rate"], params["baseline_null_rate"])
+		}
+
+		v, _ = checker.NullRateStable(current, baseline, "email", 0.1)
+		if !v {
+			t.Error("Expected a 9 point rise to pass with a 10 point allowance")
+		}
+		v, _ = checker.NullRateStable(baseline, current, "email", 0)
+		if !v {
+			t.Error("Expected a falling null rate to pass")
+		}
+	})
+
+	t.Run("DoesSchemaMatch", func(t *testing.T) {
+		path := writeTempCSV(t, "id,name,email\n1,Alice,a@example.com\n")
+
+		v, err := checker.DoesSchemaMatch(path, []string{"id", "name", "email"}, true)
+		if err != nil {
+			t.Fatalf("DoesSchemaMatch failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected the exact column list to pass in strict mode")
+		}
+
+		v, _ = checker.DoesSchemaMatch(path, []string{"email", "id"}, false)
+		if !v {
+			t.Error("Expected a subset in any order to pass in non-strict mode")
+		}
+		v, _ = checker.DoesSchemaMatch(path, []string{"email", "id"}, true)
+		if v {
+			t.Error("Expected extra and reordered columns to fail in strict mode")
+		}
+		params := checker.LastResultParams()
+		if extra := params["extra_columns"].([]string); len(extra) != 1 || extra[0] != "name" {
+			t.Errorf("Expected name to be reported as extra, got %v", extra)
+		}
+		if actual := params["actual_columns"].([]string); len(actual) != 3 {
+			t.Errorf("Expected the actual columns to be logged, got %v", actual)
+		}
+
+		v, _ = checker.DoesSchemaMatch(path, []string{"id", "phone"}, false)
+		if v {
+			t.Error("Expected a missing column to fail in non-strict mode")
+		}
+		if missing := checker.LastResultParams()["missing_columns"].([]string); len(missing) != 1 || missing[0] != "phone" {
+			t.Errorf("Expected phone to be reported as missing, got %v", missing)
+		}
+	})
+
+	t.Run("IsColumnNumericLocale", func(t *testing.T) {
+		us := writeTempCSV(t, "amount\n\"1,234.56\"\n12.5\n-1000\n\n")
+		german := writeTempCSV(t, "amount\n\"1.234,56\"\n\"12,5\"\n-1000\n")
+
+		v, err := checker.IsColumnNumericLocale(us, "amount", "en-US")
+		if err != nil {
+			t.Fatalf("IsColumnNumericLocale failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected US-formatted numbers to pass en-US")
+		}
+		v, _ = checker.IsColumnNumericLocale(us, "amount", "de-DE")
+		if v {
+			t.Error("Expected 1,234.56 to fail de-DE")
+		}
+		v, _ = checker.IsColumnNumericLocale(german, "amount", "de-DE")
+		if !v {
+			t.Error("Expected German-formatted numbers to pass de-DE")
+		}
+		v, _ = checker.IsColumnNumericLocale(german, "amount", "en-US")
+		if v {
+			t.Error("Expected 1.234,56 to fail en-US")
+		}
+
+		badGrouping := writeTempCSV(t, "amount\n\"12,34.5\"\n")
+		v, _ = checker.IsColumnNumericLocale(badGrouping, "amount", "en-US")
+		if v {
+			t.Error("Expected groups not of three digits to fail")
+		}
+
+		if _, err := checker.IsColumnNumericLocale(us, "amount", "xx-XX"); err == nil {
+			t.Error("Expected error for unsupported locale")
+		}
+	})
+
+	t.Run("DoesSchemaTypesMatch", func(t *testing.T) {
+		path := writeTempCSV(t, "id,name,amount\n1,Alice,1.5\n2,Bob,x\n")
+
+		types, err := ParseColumnTypes("id:BIGINT, name:VARCHAR(20)")
+		if err != nil {
+			t.Fatalf("ParseColumnTypes failed: %v", err)
+		}
+		v, err := checker.DoesSchemaTypesMatch(path, types)
+		if err != nil {
+			t.Fatalf("DoesSchemaTypesMatch failed: %v", err)
+		}
+		if !v {
+			t.Errorf("Expected matching types to pass, got %v", checker.LastResultParams())
+		}
+
+		v, _ = checker.DoesSchemaTypesMatch(path, map[string]string{"id": "INT8", "amount": "DOUBLE", "created_at": "DATE"})
+		if v {
+			t.Error("Expected a drifted and a missing column to fail")
+		}
+		params := checker.LastResultParams()
+		if mismatches := params["type_mismatches"].([]string); len(mismatches) != 1 || mismatches[0] != "amount: expected DOUBLE, got VARCHAR" {
+			t.Errorf("Expected only amount to be reported as drifted, got %v", mismatches)
+		}
+		if missing := params["missing_columns"].([]string); len(missing) != 1 || missing[0] != "created_at" {
+			t.Errorf("Expected created_at to be reported as missing, got %v", missing)
+		}
+
+		types, err = ParseColumnTypes("price:DECIMAL(10,2),id:BIGINT")
+		if err != nil || len(types) != 2 || types["price"] != "DECIMAL(10,2)" {
+			t.Errorf("Expected commas inside a type to be kept, got %v (err: %v)", types, err)
+		}
+		if _, err := ParseColumnTypes("id"); err == nil {
+			t.Error("Expected error for an entry without a type")
+		}
+	})
+
+	t.Run("IsColumnConsistentPrecision", func(t *testing.T) {
+		mixed := writeTempCSV(t, "id,ts\n1,2024-01-01 12:00:00\n2,2024-01-01 12:00:00.123\n3,2024-01-01 12:00:01\n4,\n")
+		v, err := checker.IsColumnConsistentPrecision(mixed, "ts")
+		if err != nil {
+			t.Fatalf("IsColumnConsistentPrecision failed: %v", err)
+		}
+		if v {
+			t.Error("Expected mixed second and millisecond precision to fail")
+		}
+		precisions := checker.LastResultParams()["precisions"].(map[string]int64)
+		if len(precisions) != 2 || precisions["0"] != 2 || precisions["3"] != 1 {
+			t.Errorf("Expected 2 values without fraction and 1 with millis, got %v", precisions)
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected the 1 minority-precision value to count as an error, got %d", count)
+		}
+
+		// Trailing zeros are part of the written precision
+		millis := writeTempCSV(t, "ts\n2024-01-01 12:00:00.100\n2024-01-01 12:00:00.123\n")
+		v, _ = checker.IsColumnConsistentPrecision(millis, "ts")
+		if !v {
+			t.Errorf("Expected consistent millisecond precision to pass, got %v", checker.LastResultParams())
+		}
+	})
+
+	t.Run("IsTableRowCountEqual", func(t *testing.T) {
+		path := writeTempCSV(t, "id\n1\n2\n3\n")
+
+		v, err := checker.IsTableRowCountEqual(path, 3)
+		if err != nil {
+			t.Fatalf("IsTableRowCountEqual failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected exactly 3 rows to pass")
+		}
+
+		v, _ = checker.IsTableRowCountEqual(path, 4)
+		if v {
+			t.Error("Expected 3 rows to fail an expected count of 4")
+		}
+		params := checker.LastResultParams()
+		if params["row_count"] != int64(3) || params["difference"] != int64(-1) {
+			t.Errorf("Expected row_count 3 and difference -1 to be logged, got %v", params)
+		}
+	})
+
+	t.Run("IsInAnyReference", func(t *testing.T) {
+		data := writeTempCSV(t, "order_id,customer_id\n1,10\n2,20\n3,30\n")
+		eu := writeTempCSV(t, "customer_id\n10\n")
+		us := writeTempCSV(t, "id,region\n20,US\n")
+
+		refs := []RefSpec{{Path: eu}, {Path: us, Key: "id"}}
+		v, err := checker.IsInAnyReference(data, "customer_id", refs)
+		if err != nil {
+			t.Fatalf("IsInAnyReference failed: %v", err)
+		}
+		if v {
+			t.Error("Expected customer 30, in neither reference, to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 unmatched row, got %d", count)
+		}
+
+		apac := writeTempCSV(t, "customer_id\n30\n30\n")
+		v, _ = checker.IsInAnyReference(data, "customer_id", append(refs, RefSpec{Path: apac}))
+		if !v {
+			t.Error("Expected every customer to be found once the third reference is added")
+		}
+
+		refsFile := filepath.Join(t.TempDir(), "refs.yaml")
+		content := fmt.Sprintf("- path: %s\n- path: %s\n  key: id\n", eu, us)
+		if err := os.WriteFile(refsFile, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		loaded, err := LoadRefSpecs(refsFile)
+		if err != nil {
+			t.Fatalf("LoadRefSpecs failed: %v", err)
+		}
+		if len(loaded) != 2 || loaded[0] != refs[0] || loaded[1] != refs[1] {
+			t.Errorf("Expected %v, got %v", refs, loaded)
+		}
+	})
+
+	t.Run("AreRowCountsEqual", func(t *testing.T) {
+		source := writeTempCSV(t, "id\n1\n2\n3\n")
+		output := writeTempCSV(t, "id,total\n1,10\n2,20\n3,30\n")
+		dropped := writeTempCSV(t, "id\n1\n2\n")
+
+		v, err := checker.AreRowCountsEqual(source, output)
+		if err != nil {
+			t.Fatalf("AreRowCountsEqual failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected equal row counts to pass")
+		}
+
+		v, _ = checker.AreRowCountsEqual(source, dropped)
+		if v {
+			t.Error("Expected a dropped row to fail")
+		}
+		params := checker.LastResultParams()
+		if params["row_count"] != int64(3) || params["reference_count"] != int64(2) || params["difference"] != int64(1) {
+			t.Errorf("Expected counts 3 and 2 with difference 1, got %v", params)
+		}
+	})
+
+	t.Run("IsColumnPositive", func(t *testing.T) {
+		positive := writeTempCSV(t, "qty\n1\n2.5\n\n")
+		withZero := writeTempCSV(t, "qty\n1\n0\n")
+		negative := writeTempCSV(t, "qty\n1\n-3\n")
+
+		v, err := checker.IsColumnPositive(positive, "qty")
+		if err != nil {
+			t.Fatalf("IsColumnPositive failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected positive values and NULLs to pass")
+		}
+		v, _ = checker.IsColumnPositive(withZero, "qty")
+		if v {
+			t.Error("Expected zero to fail IsColumnPositive")
+		}
+
+		v, err = checker.IsColumnNonNegative(withZero, "qty")
+		if err != nil {
+			t.Fatalf("IsColumnNonNegative failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected zero to pass IsColumnNonNegative")
+		}
+		v, _ = checker.IsColumnNonNegative(negative, "qty")
+		if v {
+			t.Error("Expected a negative value to fail IsColumnNonNegative")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 negative value, got %d", count)
+		}
+	})
+
+	t.Run("PopulationStabilityIndexBelow", func(t *testing.T) {
+		series := func(from, to int) string {
+			var rows strings.Builder
+			rows.WriteString("score\n")
+			for i := from; i < to; i++ {
+				fmt.Fprintf(&rows, "%d\n", i)
+			}
+			return writeTempCSV(t, rows.String())
+		}
+		baseline := series(0, 100)
+		same := series(0, 100)
+		shifted := series(50, 150)
+
+		v, err := checker.PopulationStabilityIndexBelow(same, baseline, "score", 0.2)
+		if err != nil {
+			t.Fatalf("PopulationStabilityIndexBelow failed: %v", err)
+		}
+		if !v {
+			t.Errorf("Expected an identical distribution to pass, got %v", checker.LastResultParams())
+		}
+		if psi := checker.LastResultParams()["psi"].(float64); psi != 0 {
+			t.Errorf("Expected PSI 0 for identical distributions, got %v", psi)
+		}
+
+		v, _ = checker.PopulationStabilityIndexBelow(shifted, baseline, "score", 0.2)
+		if v {
+			t.Error("Expected a distribution shifted by half its range to exceed PSI 0.2")
+		}
+		params := checker.LastResultParams()
+		if psi := params["psi"].(float64); psi <= 0.2 {
+			t.Errorf("Expected PSI above 0.2, got %v", psi)
+		}
+		shifts := params["shifted_bins"].([]BinShift)
+		if len(shifts) == 0 || shifts[0].Current <= shifts[0].Baseline {
+			t.Errorf("Expected the most-shifted bin to be the open top bin that gained rows, got %v", shifts)
+		}
+
+		categories := writeTempCSV(t, "plan\nfree\nfree\nfree\npro\n")
+		flipped := writeTempCSV(t, "plan\nfree\npro\npro\npro\n")
+		v, _ = checker.PopulationStabilityIndexBelow(flipped, categories, "plan", 0.2)
+		if v {
+			t.Error("Expected flipped category shares to exceed PSI 0.2")
+		}
+	})
+
+	t.Run("IsColumnTrimmed", func(t *testing.T) {
+		clean := writeTempCSV(t, "id,name\n1,ACME\n2,Big Corp\n3,\n")
+		v, err := checker.IsColumnTrimmed(clean, "name")
+		if err != nil {
+			t.Fatalf("IsColumnTrimmed failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected trimmed values, inner spaces, and NULLs to pass")
+		}
+
+		messy := writeTempCSV(t, "id,name\n1,\"ACME \"\n2,\" Big Corp\"\n3,\"Tab\t\"\n4,Fine\n")
+		v, _ = checker.IsColumnTrimmed(messy, "name")
+		if v {
+			t.Error("Expected values with surrounding whitespace to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 3 {
+			t.Errorf("Expected 3 untrimmed values, got %d", count)
+		}
+	})
+
+	t.Run("IsColumnNotBlank", func(t *testing.T) {
+		filled := writeTempCSV(t, "id,name\n1,Alice\n2,\" Bob \"\n")
+		v, err := checker.IsColumnNotBlank(filled, "name")
+		if err != nil {
+			t.Fatalf("IsColumnNotBlank failed: %v", err)
+		}
+		if !v {
+			t.Error("Expected non-blank values to pass")
+		}
+
+		blank := writeTempCSV(t, "id,name\n1,Alice\n2,\"   \"\n3,\n4,\"\t\"\n")
+		v, _ = checker.IsColumnNotBlank(blank, "name")
+		if v {
+			t.Error("Expected whitespace-only and NULL values to fail")
+		}
+		params := checker.LastResultParams()
+		if params["blank_count"] != int64(2) || params["null_count"] != int64(1) {
+			t.Errorf("Expected 2 blank and 1 NULL values, got %v", params)
+		}
+
+		v, _ = checker.IsColumnNotNull(blank, "name")
+		if v {
+			t.Fatal("Expected the NULL row to fail IsColumnNotNull too")
+		}
+		whitespaceOnly := writeTempCSV(t, "id,name\n1,Alice\n2,\"   \"\n")
+		if v, _ := checker.IsColumnNotNull(whitespaceOnly, "name"); !v {
+			t.Error("Expected IsColumnNotNull to pass whitespace-only values")
+		}
+		if v, _ := checker.IsColumnNotBlank(whitespaceOnly, "name"); v {
+			t.Error("Expected IsColumnNotBlank to fail whitespace-only values")
+		}
+	})
+
+	t.Run("IsColumnEnumWithSuggestions", func(t *testing.T) {
+		path := writeTempCSV(t, "status\nactive\nactiv\nactiv\npendng\nzzz\ninactive\n")
+		allowed := []string{"active", "inactive", "pending"}
+
+		v, err := checker.IsColumnEnumWithSuggestions(path, "status", allowed, 2)
+		if err != nil {
+			t.Fatalf("IsColumnEnumWithSuggestions failed: %v", err)
+		}
+		if v {
+			t.Error("Expected invalid values to fail even with suggestions")
+		}
+		params := checker.LastResultParams()
+		suggestions := params["suggestions"].(map[string]string)
+		if suggestions["activ"] != "active" || suggestions["pendng"] != "pending" {
+			t.Errorf("Expected activ -> active and pendng -> pending, got %v", suggestions)
+		}
+		if _, ok := suggestions["zzz"]; ok {
+			t.Errorf("Expected no suggestion for zzz, got %q", suggestions["zzz"])
+		}
+		if count, _ := checker.LastErrorCount(); count != 4 {
+			t.Errorf("Expected 4 invalid rows, got %d", count)
+		}
+
+		v, _ = checker.IsColumnEnumWithSuggestions(path, "status", append(allowed, "activ", "pendng", "zzz"), 2)
+		if !v {
+			t.Error("Expected the check to pass once every value is allowed")
+		}
+	})
+
+	t.Run("WithinRollingBounds", func(t *testing.T) {
+		// Readings climb from 20 to 80, so a 60 is within global bounds but far above its neighbours' 20s
+		path := writeTempCSV(t, "ts,reading\n1,20\n2,21\n3,60\n4,20\n5,22\n6,40\n7,41\n8,40\n9,79\n10,80\n11,81\n")
+
+		v, err := checker.IsColumnBetween(path, "reading", 0, 100)
+		if err != nil || !v {
+			t.Fatalf("Expected every reading to be within global bounds, got %v (err: %v)", v, err)
+		}
+
+		v, err = checker.WithinRollingBounds(path, "reading", "ts", 2, 30)
+		if err != nil {
+			t.Fatalf("WithinRollingBounds failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the local spike to fail the rolling check")
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected the spike at ts 3 and the jump at ts 9 to deviate, got %d", count)
+		}
+
+		v, _ = checker.WithinRollingBounds(path, "reading", "ts", 2, 40)
+		if !v {
+			t.Errorf("Expected all deviations to be within 40, got %v", checker.LastResultParams())
+		}
+
+		if _, err := checker.WithinRollingBounds(path, "reading", "ts", 0, 1); err == nil {
+			t.Error("Expected error for a window size below 1")
+		}
+	})
+
+	t.Run("DistributionIsBalanced", func(t *testing.T) {
+		// A 60/40 split deviates 0.1 from the expected 0.5
+		path := writeTempCSV(t, "user_id,variant\n1,A\n2,A\n3,A\n4,B\n5,B\n6,A\n7,A\n8,B\n9,B\n10,A\n")
+
+		v, err := checker.DistributionIsBalanced(path, "variant", 0.05)
+		if err != nil {
+			t.Fatalf("DistributionIsBalanced failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the skewed A/B split to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 || checker.LastResultParams()["most_imbalanced_value"] != "A" {
+			t.Errorf("Expected both variants imbalanced with A first, got %v", checker.LastResultParams())
+		}
+
+		v, _ = checker.DistributionIsBalanced(path, "variant", 0.1)
+		if !v {
+			t.Errorf("Expected the split to be within 0.1, got %v", checker.LastResultParams())
+		}
+
+		if _, err := checker.DistributionIsBalanced(path, "variant", 2); err == nil {
+			t.Error("Expected error for a max imbalance above 1")
+		}
+	})
+
+	t.Run("GlobSchemaConsistent", func(t *testing.T) {
+		dir := t.TempDir()
+		for name, content := range map[string]string{
+			"part-0.csv": "id,amount\n1,9.5\n",
+			"part-1.csv": "id,amount\n2,3.5\n",
+			"part-2.csv": "id,amount,discount\n3,4.5,1\n",
+		} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		v, err := checker.GlobSchemaConsistent(filepath.Join(dir, "part-[01].csv"))
+		if err != nil || !v {
+			t.Errorf("Expected matching schemas to pass, got %v (err: %v)", v, err)
+		}
+
+		v, err = checker.GlobSchemaConsistent(filepath.Join(dir, "*.csv"))
+		if err != nil {
+			t.Fatalf("GlobSchemaConsistent failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the file with an extra column to fail")
+		}
+		divergent, _ := checker.LastResultParams()["divergent_files"].(map[string][]string)
+		if got := divergent[filepath.Join(dir, "part-2.csv")]; len(divergent) != 1 || len(got) != 1 || got[0] != "extra columns: discount" {
+			t.Errorf("Expected only part-2.csv to diverge with an extra column, got %v", divergent)
+		}
+
+		if _, err := checker.GlobSchemaConsistent(filepath.Join(dir, "*.parquet")); err == nil {
+			t.Error("Expected error for a glob matching no files")
+		}
+	})
+
+	t.Run("IsColumnMatchesGrammar", func(t *testing.T) {
+		dir := t.TempDir()
+		grammarPath := filepath.Join(dir, "brackets.ebnf")
+		grammar := "// balanced parentheses\nBalanced = { Pair } .\nPair     = \"(\" Balanced \")\" .\n"
+		if err := os.WriteFile(grammarPath, []byte(grammar), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := checker.IsColumnMatchesGrammar(writeTempCSV(t, "code\n(()())\n()\n\n"), "code", grammarPath)
+		if err != nil || !v {
+			t.Errorf("Expected balanced codes to match, got %v (err: %v)", v, err)
+		}
+
+		v, err = checker.IsColumnMatchesGrammar(writeTempCSV(t, "code\n(()())\n(()\n(()\n"), "code", grammarPath)
+		if err != nil {
+			t.Fatalf("IsColumnMatchesGrammar failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the unbalanced code to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected 2 failing rows, got %d", count)
+		}
+
+		if _, err := checker.IsColumnMatchesGrammar(writeTempCSV(t, "code\n()\n"), "code", filepath.Join(dir, "missing.ebnf")); err == nil {
+			t.Error("Expected error for a missing grammar file")
+		}
+	})
+
+	t.Run("Score", func(t *testing.T) {
+		path := writeTempCSV(t, "id,name\n1,Alice\n1,\n")
+		checks := func(uniqueWeight float64) []Check {
+			return []Check{
+				{Name: "ids unique", Weight: uniqueWeight, Run: func() (bool, error) { return checker.IsColumnUnique(path, "id") }},
+				{Name: "id present", Weight: 3, Run: func() (bool, error) { return checker.IsColumnNotNull(path, "id") }},
+				{Name: "bad column", Weight: 1, Run: func() (bool, error) { return checker.IsColumnNotNull(path, "missing") }},
+			}
+		}
+
+		score, err := checker.Score(path, checks(1))
+		if err != nil {
+			t.Fatalf("Score failed: %v", err)
+		}
+		if score != 60 {
+			t.Errorf("Expected only the weight-3 check of 5 to pass for a score of 60, got %v", score)
+		}
+		params := checker.LastResultParams()
+		if params["data_path"] != path || params["score"] != float64(60) || len(params["contributions"].([]ScoreContribution)) != 3 {
+			t.Errorf("Expected Score to log its data path and contributions, got %v", params)
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected Score to count the failing and the erroring check, got %d", count)
+		}
+
+		// Weighting the failing uniqueness check more heavily pulls the same results below the threshold
+		for _, tc := range []struct {
+			weight float64
+			want   bool
+		}{{1, true}, {6, false}} {
+			v, err := checker.IsScoreAtLeast(path, checks(tc.weight), 50)
+			if err != nil {
+				t.Fatalf("IsScoreAtLeast failed: %v", err)
+			}
+			if v != tc.want {
+				t.Errorf("With the unique check weighted %v, expected pass=%v, got %v", tc.weight, tc.want, checker.LastResultParams())
+			}
+		}
+		contributions, _ := checker.LastResultParams()["contributions"].([]ScoreContribution)
+		if len(contributions) != 3 || contributions[0].Worth != 60 || contributions[1].Points != 30 || contributions[2].Error == "" {
+			t.Errorf("Unexpected contributions: %+v", contributions)
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected the failing and the erroring check to be counted, got %d", count)
+		}
+
+		if _, err := checker.Score(path, nil); err == nil {
+			t.Error("Expected error for a score without checks")
+		}
+		if _, err := checker.Score(path, checks(0)); err == nil {
+			t.Error("Expected error for a zero weight")
+		}
+
+		// The logged duration covers the whole score, even when no check touches DuckDB
+		fresh, _ := setup(t)
+		slow := []Check{{Name: "slow", Weight: 1, Run: func() (bool, error) { time.Sleep(20 * time.Millisecond); return true, nil }}}
+		if _, err := fresh.Score(path, slow); err != nil {
+			t.Fatalf("Score failed: %v", err)
+		}
+		entries, err := fresh.dbConnector.GetLogs(db.LogFilter{CheckType: "data_quality_score"})
+		if err != nil || len(entries) != 1 {
+			t.Fatalf("Expected one score entry, got %v (err: %v)", entries, err)
+		}
+		if d := entries[0].DurationMs; d < 20 || d > 10000 {
+			t.Errorf("Expected the score's own duration of about 20ms, got %dms", d)
+		}
+	})
+
+	t.Run("IsColumnSubsetOfReference", func(t *testing.T) {
+		dir := t.TempDir()
+		catalog := filepath.Join(dir, "catalog.csv")
+		sales := filepath.Join(dir, "sales.csv")
+		if err := os.WriteFile(catalog, []byte("catalog_sku,name\nA1,Apple\nB2,Banana\nB2,Banana (duplicate)\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(sales, []byte("sku,qty\nA1,1\nB2,2\n,3\nZ9,1\nZ9,2\nX5,1\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := checker.IsColumnSubsetOfReference(sales, "sku", catalog, "catalog_sku")
+		if err != nil {
+			t.Fatalf("IsColumnSubsetOfReference failed: %v", err)
+		}
+		if v {
+			t.Error("Expected skus missing from the catalog to fail")
+		}
+		params := checker.LastResultParams()
+		if count, _ := checker.LastErrorCount(); count != 3 || params["orphan_values"] != int64(2) {
+			t.Errorf("Expected 3 orphan rows with 2 distinct values, got %v", params)
+		}
+		if offenders, _ := params["offenders"].([]string); len(offenders) != 2 || offenders[0] != "X5" {
+			t.Errorf("Expected offenders [X5 Z9], got %v", params["offenders"])
+		}
+
+		v, err = checker.IsColumnSubsetOfReference(catalog, "catalog_sku", sales, "sku")
+		if err != nil || !v {
+			t.Errorf("Expected every catalog sku to be sold, got %v (err: %v)", v, err)
+		}
+	})
+
+	t.Run("IsColumnWithinZScore", func(t *testing.T) {
+		// Nineteen readings of 10 and a spike of 100, which lies about 4.25 standard deviations out
+		path := writeTempCSV(t, "reading\n"+strings.Repeat("10\n", 19)+"100\n\n")
+
+		v, err := checker.IsColumnWithinZScore(path, "reading", 3)
+		if err != nil {
+			t.Fatalf("IsColumnWithinZScore failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the spike to be an outlier")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 outlier, got %d", count)
+		}
+
+		v, _ = checker.IsColumnWithinZScore(path, "reading", 5)
+		if !v {
+			t.Errorf("Expected no outliers beyond 5 standard deviations, got %v", checker.LastResultParams())
+		}
+
+		v, err = checker.IsColumnWithinZScore(writeTempCSV(t, "reading\n7\n7\n7\n"), "reading", 1)
+		if err != nil || !v {
+			t.Errorf("Expected identical values to pass, got %v (err: %v)", v, err)
+		}
+
+		if _, err := checker.IsColumnWithinZScore(path, "reading", 0); err == nil {
+			t.Error("Expected error for a non-positive max z-score")
+		}
+	})
+
+	t.Run("IsColumnWithinIQR", func(t *testing.T) {
+		// Q1 = 2.25 and Q3 = 4.75, so the 1.5 fences are [-1.5, 8.5]
+		path := writeTempCSV(t, "amount\n1\n2\n3\n4\n5\n50\n\n")
+
+		v, err := checker.IsColumnWithinIQR(path, "amount", 1.5)
+		if err != nil {
+			t.Fatalf("IsColumnWithinIQR failed: %v", err)
+		}
+		if v {
+			t.Error("Expected 50 to fall outside the IQR fences")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 outlier, got %d", count)
+		}
+
+		v, _ = checker.IsColumnWithinIQR(path, "amount", 25)
+		if !v {
+			t.Errorf("Expected no outliers with k=25, got %v", checker.LastResultParams())
+		}
+
+		if _, err := checker.IsColumnWithinIQR(path, "amount", -1); err == nil {
+			t.Error("Expected error for a negative k")
+		}
+	})
+
+	t.Run("AreColumnsMutuallyExclusive", func(t *testing.T) {
+		path := writeTempCSV(t, "id,home_phone,mobile_phone\n1,555-0100,\n2,,555-0101\n3,,\n4,555-0102,555-0103\n")
+
+		v, err := checker.AreColumnsMutuallyExclusive(path, "home_phone", "mobile_phone")
+		if err != nil {
+			t.Fatalf("AreColumnsMutuallyExclusive failed: %v", err)
+		}
+		if v {
+			t.Error("Expected row 4 with both phones to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 violating row, got %d", count)
+		}
+
+		v, _ = checker.AreColumnsMutuallyExclusive(path, "id", "mobile_phone")
+		if v {
+			t.Error("Expected id and mobile_phone to overlap")
+		}
+	})
+
+	t.Run("IsAtLeastOneNotNull", func(t *testing.T) {
+		path := writeTempCSV(t, "id,email,phone,address\n1,a@example.com,,\n2,,555-0100,\n3,,,\n4,,,1 Main St\n")
+
+		v, err := checker.IsAtLeastOneNotNull(path, []string{"email", "phone", "address"})
+		if err != nil {
+			t.Fatalf("IsAtLeastOneNotNull failed: %v", err)
+		}
+		if v {
+			t.Error("Expected row 3 with no contact details to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 violating row, got %d", count)
+		}
+
+		v, _ = checker.IsAtLeastOneNotNull(path, []string{"id", "email"})
+		if !v {
+			t.Error("Expected every row to have an id or an email")
+		}
+
+		if _, err := checker.IsAtLeastOneNotNull(path, nil); err == nil {
+			t.Error("Expected error for no columns")
+		}
+	})
+
+	t.Run("SetMembershipApostrophes", func(t *testing.T) {
+		path := writeTempCSV(t, "surname\nO'Brien\nSmith\n")
+
+		v, err := checker.IsColumnEnum(path, "surname", []string{"O'Brien", "Smith"})
+		if err != nil || !v {
+			t.Errorf("Expected enum with an apostrophe to pass, got %v (err: %v)", v, err)
+		}
+		v, err = checker.AreDistinctValuesInSet(path, "surname", []string{"O'Brien", "Smith"})
+		if err != nil || !v {
+			t.Errorf("Expected distinct-in-set with an apostrophe to pass, got %v (err: %v)", v, err)
+		}
+		v, err = checker.IsColumnNotInSet(path, "surname", []string{"O'Brien"})
+		if err != nil || v {
+			t.Errorf("Expected not-in-set to find O'Brien, got %v (err: %v)", v, err)
+		}
+	})
+
+	t.Run("SetMembershipIgnoreCase", func(t *testing.T) {
+		path := writeTempCSV(t, "status\nActive\nactive\nINACTIVE\n")
+
+		v, _ := checker.IsColumnEnum(path, "status", []string{"active", "inactive"})
+		if v {
+			t.Error("Expected a case-sensitive enum to reject Active")
+		}
+		v, err := checker.IsColumnEnumIgnoreCase(path, "status", []string{"active", "inactive"}, true)
+		if err != nil || !v {
+			t.Errorf("Expected a case-insensitive enum to pass, got %v (err: %v)", v, err)
+		}
+		if checker.LastResultParams()["ignore_case"] != true {
+			t.Errorf("Expected ignore_case to be logged, got %v", checker.LastResultParams())
+		}
+
+		v, _ = checker.IsColumnNotInSetIgnoreCase(path, "status", []string{"inactive"}, true)
+		if v {
+			t.Error("Expected a case-insensitive not-in-set to find INACTIVE")
+		}
+		v, _ = checker.IsColumnNotInSetIgnoreCase(path, "status", []string{"inactive"}, false)
+		if !v {
+			t.Error("Expected a case-sensitive not-in-set to ignore INACTIVE")
+		}
+
+		v, _ = checker.AreDistinctValuesInSetIgnoreCase(path, "status", []string{"ACTIVE", "Inactive"}, true)
+		if !v {
+			t.Error("Expected a case-insensitive distinct-in-set to pass")
+		}
+	})
+
+	t.Run("IsColumnValidJSON", func(t *testing.T) {
+		path := writeTempCSV(t, "id,payload\n1,\"{\"\"a\"\": 1}\"\n2,\"[1, 2]\"\n3,\n4,\"{\"\"a\"\": \"\n")
+
+		v, err := checker.IsColumnValidJSON(path, "payload")
+		if err != nil {
+			t.Fatalf("IsColumnValidJSON failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the truncated document to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 invalid document (nulls skipped), got %d", count)
+		}
+
+		v, err = checker.IsColumnValidJSON(path, "id")
+		if err != nil || !v {
+			t.Errorf("Expected numbers to be valid JSON, got %v (err: %v)", v, err)
+		}
+	})
+
+	t.Run("IsColumnIsWholeNumber", func(t *testing.T) {
+		path := writeTempCSV(t, "quantity,price\n3.0,9.99\n-2.0,5.00\n,1.50\n10.0,\n")
+
+		v, err := checker.IsColumnIsWholeNumber(path, "quantity")
+		if err != nil || !v {
+			t.Errorf("Expected whole values stored as floats to pass, got %v (err: %v)", v, err)
+		}
+
+		v, err = checker.IsColumnIsWholeNumber(path, "price")
+		if err != nil {
+			t.Fatalf("IsColumnIsWholeNumber failed: %v", err)
+		}
+		if v {
+			t.Error("Expected fractional prices to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected 2 fractional values, got %d", count)
+		}
+	})
+
+	t.Run("IsColumnScaleAtMost", func(t *testing.T) {
+		path := writeTempCSV(t, "amount\n12\n9.99\n1.500\n0.125\n\n")
+
+		v, err := checker.IsColumnScaleAtMost(path, "amount", 2)
+		if err != nil {
+			t.Fatalf("IsColumnScaleAtMost failed: %v", err)
+		}
+		if v {
+			t.Error("Expected 0.125 to exceed a scale of 2")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 value over the scale (trailing zeros ignored), got %d", count)
+		}
+		if got := checker.LastResultParams()["largest_scale"]; got != int64(3) {
+			t.Errorf("Expected a largest scale of 3, got %v", got)
+		}
+
+		v, _ = checker.IsColumnScaleAtMost(path, "amount", 3)
+		if !v {
+			t.Error("Expected every amount to fit a scale of 3")
+		}
+
+		if _, err := checker.IsColumnScaleAtMost(path, "amount", -1); err == nil {
+			t.Error("Expected error for a negative scale")
+		}
+	})
+
+	t.Run("IsColumnPrefixed", func(t *testing.T) {
+		path := writeTempCSV(t, "sku\nSKU-001\nSKU-002\nsku-003\n\n")
+
+		v, err := checker.IsColumnPrefixed(path, "sku", "SKU-")
+		if err != nil {
+			t.Fatalf("IsColumnPrefixed failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the lowercase sku-003 to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 unprefixed value, got %d", count)
+		}
+
+		// LIKE wildcards in the prefix match literally
+		path = writeTempCSV(t, "code\n50%_off\n50%_discount\n")
+		if v, _ := checker.IsColumnPrefixed(path, "code", "50%_"); !v {
+			t.Error("Expected a literal 50%_ prefix to match")
+		}
+		if v, _ := checker.IsColumnPrefixed(path, "code", "5_%d"); v {
+			t.Error("Expected _ and % not to act as wildcards")
+		}
+
+		path = writeTempCSV(t, "zip\n00501\n00544\n")
+		if v, _ := checker.IsColumnPrefixed(path, "zip", "005"); !v {
+			t.Error("Expected leading zeros to be kept")
+		}
+	})
+
+	t.Run("IsColumnSuffixed", func(t *testing.T) {
+		path := writeTempCSV(t, "host\napi.example.com\nwww.example.com\nexample.org\n\n")
+
+		v, err := checker.IsColumnSuffixed(path, "host", ".example.com")
+		if err != nil {
+			t.Fatalf("IsColumnSuffixed failed: %v", err)
+		}
+		if v {
+			t.Error("Expected example.org to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 value without the suffix, got %d", count)
+		}
+
+		path = writeTempCSV(t, "file\nreport_2024.csv\nO'Brien.csv\n")
+		if v, err := checker.IsColumnSuffixed(path, "file", ".csv"); err != nil || !v {
+			t.Errorf("Expected every file to end in .csv, got %v (err: %v)", v, err)
+		}
+		if v, _ := checker.IsColumnSuffixed(path, "file", "_.csv"); v {
+			t.Error("Expected _ not to act as a wildcard")
+		}
+	})
+
+	t.Run("IsColumnContains", func(t *testing.T) {
+		path := writeTempCSV(t, "note\nexported by etl [v2]\nexported by etl [v2], password=hunter2\nmanual entry\n\n")
+
+		v, err := checker.IsColumnContains(path, "note", "[v2]")
+		if err != nil {
+			t.Fatalf("IsColumnContains failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the manual entry without the marker to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 value without the marker, got %d", count)
+		}
+
+		v, err = checker.IsColumnNotContains(path, "note", "password=")
+		if err != nil {
+			t.Fatalf("IsColumnNotContains failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the leaked password to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 1 {
+			t.Errorf("Expected 1 value with the forbidden token, got %d", count)
+		}
+
+		if v, _ := checker.IsColumnNotContains(path, "note", "%"); !v {
+			t.Error("Expected % to match literally")
+		}
+		if _, err := checker.IsColumnContains(path, "note", ""); err == nil {
+			t.Error("Expected error for an empty substring")
+		}
+	})
+
+	t.Run("IsColumnDateBetween", func(t *testing.T) {
+		path := writeTempCSV(t, "signup\n2024-01-15\n2024-06-30\n2023-12-31\nnot a date\n\n")
+
+		v, err := checker.IsColumnDateBetween(path, "signup", "2024-01-01", "2024-12-31")
+		if err != nil {
+			t.Fatalf("IsColumnDateBetween failed: %v", err)
+		}
+		if v {
+			t.Error("Expected the 2023 date and the unparseable value to fail")
+		}
+		params := checker.LastResultParams()
+		if params["unparseable_count"] != int64(1) || params["out_of_range_count"] != int64(1) {
+			t.Errorf("Expected 1 unparseable and 1 out-of-range value, got %v", params)
+		}
+
+		v, _ = checker.IsColumnDateBetween(writeTempCSV(t, "d\n2024-01-01\n2024-12-31\n"), "d", "2024-01-01", "2024-12-31")
+		if !v {
+			t.Error("Expected the bounds to be inclusive")
+		}
+
+		if _, err := checker.IsColumnDateBetween(path, "signup", "2024-13-01", "2024-12-31"); err == nil {
+			t.Error("Expected error for an invalid min date")
+		}
+		if _, err := checker.IsColumnDateBetween(path, "signup", "2024-12-31", "2024-01-01"); err == nil {
+			t.Error("Expected error for min after max")
+		}
+	})
+
+	t.Run("IsColumnDateNotInFuture", func(t *testing.T) {
+		path := writeTempCSV(t, "event_time\n2024-05-31 23:59:59\n2024-06-01 00:00:00\n2024-06-01 12:00:00\n2999-01-01 00:00:00\n\n")
+		asOf := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		v, err := checker.IsColumnDateNotInFutureAsOf(path, "event_time", asOf)
+		if err != nil {
+			t.Fatalf("IsColumnDateNotInFutureAsOf failed: %v", err)
+		}
+		if v {
+			t.Error("Expected values after the reference time to fail")
+		}
+		if count, _ := checker.LastErrorCount(); count != 2 {
+			t.Errorf("Expected 2 future values, got %d", count)
+		}
+		if latest := checker.LastResultParams()["latest"]; latest != "2999-01-01 00:00:00" {
+			t.Errorf("Expected the latest value to be logged, got %v", latest)
+		}
+
+		// A date is its midnight, so today's date is not in the future
+		dates := writeTempCSV(t, "day\n2024-05-31\n2024-06-01\n")
+		if v, _ := checker.IsColumnDateNotInFutureAsOf(dates, "day", asOf); !v {
+			t.Error("Expected today's date not to be in the future")
+		}
+
+		v, err = checker.IsColumnDateNotInFuture(path, "event_time")
+		if err != nil || v {
+			t.Errorf("Expected the 2999 value to be in the future of now, got %v (err: %v)", v, err)
+		}
+	})
+
+	t.Run("IsDataFresh", func(t *testing.T) {
+		path := writeTempCSV(t, "loaded_at\n2024-06-01 08:00:00\n2024-06-01 10:30:00\n\n")
+		asOf := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		v, err := checker.IsDataFreshAsOf(path, "loaded_at", 2*time.Hour, asOf)
+		if err != nil || !v {
+			t.Errorf("Expected data loaded 90 minutes ago to be fresh, got %v (err: %v)", v, err)
+		}
+		params := checker.LastResultParams()
+		if params["latest"] != "2024-06-01 10:30:00" || params["age"] != "1h30m0s" {
+			t.Errorf("Expected the latest timestamp and its age to be logged, got %v", params)
+		}
+
+		v, _ = checker.IsDataFreshAsOf(path, "loaded_at", time.Hour, asOf)
+		if v {
+			t.Error("Expected data loaded 90 minutes ago to be stale with a 1h max age")
+		}
+
+		v, err = checker.IsDataFresh(path, "loaded_at", time.Hour)
+		if err != nil || v {
+			t.Errorf("Expected 2024 data to be stale now, got %v (err: %v)", v, err)
+		}
+
+		v, err = checker.IsDataFreshAsOf(writeTempCSV(t, "loaded_at,id\n,1\n"), "loaded_at", time.Hour, asOf)
+		if err != nil || v {
+			t.Errorf("Expected a column without timestamps to fail, got %v (err: %v)", v, err)
+		}
+
+		if _, err := checker.IsDataFresh(path, "loaded_at", 0); err == nil {
+			t.Error("Expected error for a non-positive max age")
+		}
+	})
+}
+
+func TestLogsAreWritten(t *testing.T) {
+	checker, dbPath := setup(t)
+	path := getTestDataPath(t, "unique_data.csv")
+
+	_, err := checker.IsColumnUnique(path, "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Connect to DB and check logs
+	sqliteDB, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqliteDB.Close()
+
+	var count int
+	err = sqliteDB.QueryRow("SELECT COUNT(*) FROM log").Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 log entry, got %d", count)
+	}
+}
+
+func TestSharedDuckDBConnection(t *testing.T) {
+	checker, _ := setup(t)
+	path := getTestDataPath(t, "unique_data.csv")
+
+	if _, err := checker.IsColumnUnique(path, "id"); err != nil {
+		t.Fatal(err)
+	}
+	first := checker.duckDB
+	if first == nil {
+		t.Fatal("Expected DuckDB handle to be opened on first check")
+	}
+
+	if _, err := checker.IsColumnNotNull(path, "name"); err != nil {
+		t.Fatal(err)
+	}
+	if checker.duckDB != first {
+		t.Error("Expected checks to reuse the same DuckDB handle")
+	}
+
+	if err := checker.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if checker.duckDB != nil {
+		t.Error("Expected Close to release the DuckDB handle")
+	}
+
+	// The checker reopens DuckDB on the next check
+	valid, err := checker.IsColumnUnique(path, "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Error("Expected unique_data.csv to be unique after reopening")
+	}
+}
+
+func TestRemotePaths(t *testing.T) {
+	for path, want := range map[string]bool{
+		"s3://bucket/data.parquet":          true,
+		"S3://bucket/data.parquet":          true,
+		"https://example.com/data.csv":      true,
+		"gs://bucket/data.csv":              true,
+		"/tmp/data.csv":                     false,
+		"data/s3://not-a-url.csv":           false,
+		filepath.Join("tests", "x.parquet"): false,
+	} {
+		if got := isRemotePath(path); got != want {
+			t.Errorf("isRemotePath(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	env := func(values map[string]string) func(string) string {
+		return func(key string) string { return values[key] }
+	}
+
+	if stmt := s3SecretStatement(env(nil)); stmt != "" {
+		t.Errorf("Expected no secret without AWS_ACCESS_KEY_ID, got %q", stmt)
+	}
+
+	stmt := s3SecretStatement(env(map[string]string{
+		"AWS_ACCESS_KEY_ID":     "AKIA",
+		"AWS_SECRET_ACCESS_KEY": "se'cret",
+		"AWS_DEFAULT_REGION":    "eu-west-1",
+		"AWS_ENDPOINT_URL":      "http://localhost:9000",
+	}))
+	for _, part := range []string{"KEY_ID 'AKIA'", "SECRET 'se''cret'", "REGION 'eu-west-1'", "ENDPOINT 'localhost:9000'", "USE_SSL false"} {
+		if !strings.Contains(stmt, part) {
+			t.Errorf("Expected secret statement to contain %q, got %q", part, stmt)
+		}
+	}
+}
+
+func TestParquetDatasetPaths(t *testing.T) {
+	checker, _ := setup(t)
+	duckInfo, err := checker.getDuckDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for i, ids := range []string{"1, 2", "2, 3"} {
+		path := filepath.Join(dir, fmt.Sprintf("part-%d.parquet", i))
+		query := fmt.Sprintf("COPY (SELECT unnest([%s]) AS id) TO %s (FORMAT PARQUET)", ids, quoteLiteral(path))
+		if _, err := duckInfo.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	glob := filepath.Join(dir, "*.parquet")
+	if got := scanExpr(glob, ScanOptions{}); got != fmt.Sprintf("read_parquet(%s, hive_partitioning=false)", quoteLiteral(glob)) {
+		t.Errorf("Unexpected scan expression for a Parquet glob: %q", got)
+	}
+
+	for _, path := range []string{glob, dir} {
+		valid, err := checker.IsTableRowCountEqual(path, 4)
+		if err != nil || !valid {
+			t.Errorf("Expected %s to read both files, got %v (err: %v, params: %v)", path, valid, err, checker.LastResultParams())
+		}
+		// Each file is unique on its own; the duplicate 2 spans both
+		valid, err = checker.IsColumnUnique(path, "id")
+		if err != nil || valid {
+			t.Errorf("Expected id to be non-unique across %s, got %v (err: %v)", path, valid, err)
+		}
+	}
+
+	if _, err := checker.IsColumnUnique(filepath.Join(dir, "*.missing.parquet"), "id"); err == nil {
+		t.Error("Expected error for a glob matching no files")
+	}
+}
+
+func TestHivePartitioning(t *testing.T) {
+	checker, _ := setup(t)
+	duckInfo, err := checker.getDuckDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for i, year := range []string{"2023", "2024"} {
+		partition := filepath.Join(dir, "year="+year)
+		if err := os.MkdirAll(partition, 0755); err != nil {
+			t.Fatal(err)
+		}
+		query := fmt.Sprintf("COPY (SELECT %d AS id) TO %s (FORMAT PARQUET)", i, quoteLiteral(filepath.Join(partition, "part-0.parquet")))
+		if _, err := duckInfo.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := checker.IsColumnEnum(dir, "year", []string{"2023", "2024"}); err == nil {
+		t.Error("Expected the partition column to be invisible without hive partitioning")
+	}
+
+	if err := checker.SetScanOptions(ScanOptions{HivePartitioning: true}); err != nil {
+		t.Fatal(err)
+	}
+	valid, err := checker.IsColumnEnum(dir, "year", []string{"2023", "2024"})
+	if err != nil || !valid {
+		t.Errorf("Expected the partition column year to pass the enum check, got %v (err: %v)", valid, err)
+	}
+	valid, err = checker.IsColumnEnum(dir, "year", []string{"2023"})
+	if err != nil || valid {
+		t.Errorf("Expected partition 2024 to fail the enum check, got %v (err: %v)", valid, err)
+	}
+}
+
+func TestTableSources(t *testing.T) {
+	for path, want := range map[string]SourceType{
+		"postgres://user@db:5432/app/public.users": SourcePostgres,
+		"PostgreSQL://db/app/users":                SourcePostgres,
+		"duckdb:///data/warehouse.duckdb/orders":   SourceDuckDB,
+		"/tmp/users.csv":                           SourceFile,
+		"s3://bucket/users.parquet":                SourceFile,
+	} {
+		if got := SourceTypeOf(path); got != want {
+			t.Errorf("SourceTypeOf(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	if got := scanExpr("postgres://user@db:5432/app/crm.users", ScanOptions{Delimiter: ";"}); got != "postgres_scan('postgres://user@db:5432/app', 'crm', 'users')" {
+		t.Errorf("Unexpected scan expression for a Postgres table: %q", got)
+	}
+	if got := scanExpr("postgres://db/app/users", ScanOptions{}); got != "postgres_scan('postgres://db/app', 'public', 'users')" {
+		t.Errorf("Expected the Postgres schema to default to public, got %q", got)
+	}
+	if _, err := parseTableSource("postgres://db"); err == nil {
+		t.Error("Expected error for a Postgres path without a table")
+	}
+
+	checker, _ := setup(t)
+	duckInfo, err := checker.getDuckDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	database := filepath.Join(t.TempDir(), "warehouse.duckdb")
+	seed := fmt.Sprintf("ATTACH %s AS seed; CREATE TABLE seed.main.users AS SELECT unnest([1, 2, 2]) AS user_id; DETACH seed;", quoteLiteral(database))
+	if _, err := duckInfo.Exec(seed); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"duckdb://" + database + "/main.users", "duckdb://" + database + "/users"} {
+		valid, err := checker.IsColumnUnique(path, "user_id")
+		if err != nil {
+			t.Fatalf("IsColumnUnique on %s failed: %v", path, err)
+		}
+		if valid {
+			t.Errorf("Expected the duplicate user_id in %s to fail", path)
+		}
+	}
+	if _, err := checker.IsColumnUnique("duckdb://"+database+"/main.missing", "user_id"); err == nil {
+		t.Error("Expected error for a missing table")
+	}
+}
+
+func TestParseGrammar(t *testing.T) {
+	g, err := ParseGrammar(`
+		// comma-separated identifiers, optionally quoted
+		List   = Item { "," Item } .
+		Item   = Ident | "\"" Quoted { Quoted } "\"" .
+		Ident  = Letter { Letter | Digit } .
+		Quoted = Letter | " " | "," .
+		Letter = "a" … "z" | "A" … "Z" | "_" .
+		Digit  = "0" … "9" .
+	`)
+	if err != nil {
+		t.Fatalf("ParseGrammar failed: %v", err)
+	}
+	for value, want := range map[string]bool{
+		"a":             true,
+		"a,b_2,c":       true,
+		`a,"x, y"`:      true,
+		"":              false,
+		"a,":            false,
+		"2a":            false,
+		`"unterminated`: false,
+	} {
+		if got := g.Matches(value); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", value, got, want)
+		}
+	}
+
+	// A repetition must leave the final "a" to the term after it
+	suffix, err := ParseGrammar(`Code = { "a" } "a" .`)
+	if err != nil {
+		t.Fatalf("ParseGrammar failed: %v", err)
+	}
+	if !suffix.Matches("aa") || suffix.Matches("") {
+		t.Error("Expected { \"a\" } \"a\" to match aa and not the empty value")
+	}
+
+	left, err := ParseGrammar(`Expr = Expr "+" Digit | Digit . Digit = "0" … "9" .`)
+	if err != nil {
+		t.Fatalf("ParseGrammar failed: %v", err)
+	}
+	if left.Matches("1+2") {
+		t.Error("Expected left recursion to fail rather than recurse forever")
+	}
+
+	for _, src := range []string{"", `A = B .`, `A = "x .`, `A = "x"`, `A "x" .`, `A = "x" . A = "y" .`, `A = "b" … "a" .`, `A = "x" . B = "y" .`} {
+		if _, err := ParseGrammar(src); err == nil {
+			t.Errorf("Expected error for grammar %q", src)
+		}
 	}
 }
 
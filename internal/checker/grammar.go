@@ -0,0 +1,185 @@
+package checker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/exp/ebnf"
+)
+
+// Grammar is an EBNF grammar, in the notation of the Go spec, used to validate structured values that a regex
+// cannot describe, such as nested brackets. Grammar files are parsed and verified by golang.org/x/exp/ebnf, and
+// the first production is the start production, which must match the whole value:
+//
+//	// balanced parentheses
+//	Balanced = { Pair } .
+//	Pair     = "(" Balanced ")" .
+//
+// Expressions are alternatives (a | b), sequences (a b), groups ((a)), options ([a]), repetitions ({a}),
+// literals ("x") and character ranges ("a" … "z"). Values are matched character by character: unlike Go's
+// lexer, no white space is skipped between tokens.
+type Grammar struct {
+	start       string
+	productions ebnf.Grammar
+}
+
+// LoadGrammar reads and parses a grammar file
+func LoadGrammar(path string) (*Grammar, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read grammar file: %w", err)
+	}
+	return parseGrammar(path, string(content))
+}
+
+// ParseGrammar parses grammar source into a Grammar.
+// Undefined and unreachable productions are rejected up front so that a typo is reported before any value is checked.
+func ParseGrammar(src string) (*Grammar, error) {
+	return parseGrammar("grammar", src)
+}
+
+// parseGrammar parses src, naming filename in error positions
+func parseGrammar(filename, src string) (*Grammar, error) {
+	productions, err := ebnf.Parse(filename, strings.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("invalid grammar: %w", err)
+	}
+
+	// ebnf.Grammar is a map, so the start production is found by its position in the source
+	var start *ebnf.Production
+	for _, prod := range productions {
+		if start == nil || prod.Pos().Offset < start.Pos().Offset {
+			start = prod
+		}
+	}
+	if start == nil {
+		return nil, fmt.Errorf("grammar defines no productions")
+	}
+	if err := ebnf.Verify(productions, start.Name.String); err != nil {
+		return nil, fmt.Errorf("invalid grammar: %w", err)
+	}
+	return &Grammar{start: start.Name.String, productions: productions}, nil
+}
+
+// Matches reports whether the start production matches all of value.
+// Every way of matching is followed, so an option or repetition never wrongly consumes input that a later
+// term needs. Results are memoized per production and position, and a left-recursive production matches
+// nothing through its recursive alternative instead of recursing forever.
+func (g *Grammar) Matches(value string) bool {
+	m := &grammarMatcher{productions: g.productions, input: value, memo: make(map[grammarMemoKey][]int)}
+	for _, end := range m.production(g.start, 0) {
+		if end == len(value) {
+			return true
+		}
+	}
+	return false
+}
+
+type grammarMemoKey struct {
+	name string
+	pos  int
+}
+
+// grammarMatcher holds the state of matching one value. memo maps a production and start position to
+// every position a match can end at; an in-progress entry is empty.
+type grammarMatcher struct {
+	productions ebnf.Grammar
+	input       string
+	memo        map[grammarMemoKey][]int
+}
+
+func (m *grammarMatcher) production(name string, pos int) []int {
+	key := grammarMemoKey{name, pos}
+	if ends, seen := m.memo[key]; seen {
+		return ends
+	}
+	m.memo[key] = nil
+	ends := m.match(m.productions[name].Expr, pos)
+	m.memo[key] = ends
+	return ends
+}
+
+// match returns the positions at which a match of expr starting at pos can end, without duplicates
+func (m *grammarMatcher) match(expr ebnf.Expression, pos int) []int {
+	switch x := expr.(type) {
+	case nil:
+		return []int{pos}
+	case ebnf.Alternative:
+		var ends []int
+		for _, alt := range x {
+			ends = addEnds(ends, m.match(alt, pos)...)
+		}
+		return ends
+	case ebnf.Sequence:
+		ends := []int{pos}
+		for _, item := range x {
+			var next []int
+			for _, end := range ends {
+				next = addEnds(next, m.match(item, end)...)
+			}
+			if len(next) == 0 {
+				return nil
+			}
+			ends = next
+		}
+		return ends
+	case *ebnf.Name:
+		return m.production(x.String, pos)
+	case *ebnf.Token:
+		if strings.HasPrefix(m.input[pos:], x.String) {
+			return []int{pos + len(x.String)}
+		}
+		return nil
+	case *ebnf.Range:
+		// Verify has checked that both bounds are single characters
+		low, _ := utf8.DecodeRuneInString(x.Begin.String)
+		high, _ := utf8.DecodeRuneInString(x.End.String)
+		r, size := utf8.DecodeRuneInString(m.input[pos:])
+		if size > 0 && r >= low && r <= high {
+			return []int{pos + size}
+		}
+		return nil
+	case *ebnf.Group:
+		return m.match(x.Body, pos)
+	case *ebnf.Option:
+		return addEnds([]int{pos}, m.match(x.Body, pos)...)
+	case *ebnf.Repetition:
+		// Repeat from every newly reached position until no new one turns up
+		ends := []int{pos}
+		for frontier := ends; len(frontier) > 0; {
+			var next []int
+			for _, start := range frontier {
+				for _, end := range m.match(x.Body, start) {
+					if !containsEnd(ends, end) {
+						ends = append(ends, end)
+						next = append(next, end)
+					}
+				}
+			}
+			frontier = next
+		}
+		return ends
+	}
+	return nil
+}
+
+// addEnds appends the positions in more that ends does not hold yet
+func addEnds(ends []int, more ...int) []int {
+	for _, end := range more {
+		if !containsEnd(ends, end) {
+			ends = append(ends, end)
+		}
+	}
+	return ends
+}
+
+func containsEnd(ends []int, end int) bool {
+	for _, e := range ends {
+		if e == end {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -18,13 +19,22 @@ type DBConnector struct {
 
 // LogEntry represents a row in the log table
 type LogEntry struct {
-	ID                   int
-	Timestamp            string
-	DataQualityCheckType string
-	Result               bool
-	AdditionalParams     string
+	ID                   int    `json:"id"`
+	Timestamp            string `json:"timestamp"`
+	DataQualityCheckType string `json:"data_quality_check_type"`
+	Result               bool   `json:"result"`
+	// AdditionalParams is the JSON-encoded params map the check logged, or "" if it logged none
+	AdditionalParams string `json:"additional_params,omitempty"`
+	// DurationMs is how long the check took; 0 for entries logged before durations were recorded
+	DurationMs int64 `json:"duration_ms"`
+	// Severity is how much a failure of the check matters: error, warn, or info.
+	// Entries logged before severities were recorded read as error.
+	Severity string `json:"severity"`
 }
 
+// DefaultSeverity is the severity recorded when a check does not set one
+const DefaultSeverity = "error"
+
 // NewDBConnector creates a new DBConnector
 func NewDBConnector(dbPath string) *DBConnector {
 	absPath, err := filepath.Abs(dbPath)
@@ -35,6 +45,8 @@ func NewDBConnector(dbPath string) *DBConnector {
 	connector := &DBConnector{dbPath: absPath}
 	if err := connector.createLogTable(); err != nil {
 		log.Printf("Warning: Failed to create log table: %v", err)
+	} else if err := connector.migrate(); err != nil {
+		log.Printf("Warning: Failed to migrate log table: %v", err)
 	}
 	return connector
 }
@@ -63,8 +75,115 @@ func (c *DBConnector) createLogTable() error {
 	return nil
 }
 
+// migrations upgrade the original log table step by step; migrations[i] brings the schema from version i to i+1.
+// Steps are only ever appended, so a database at any earlier version can be brought current in place.
+var migrations = []func(tx *sql.Tx) error{
+	// 1: record check durations
+	func(tx *sql.Tx) error { return addColumnIfMissing(tx, "log", "duration_ms", "INTEGER") },
+	// 2: record check severities
+	func(tx *sql.Tx) error { return addColumnIfMissing(tx, "log", "severity", "TEXT") },
+}
+
+// migrate applies every migration newer than the version stored in the schema_version table.
+// Each step runs in its own transaction together with the version bump, so a failed step leaves the
+// database at the last good version and is retried on the next run. Upgrading dqc over an existing
+// quality_checks.db therefore never hits "no such column" errors or loses logged data.
+func (c *DBConnector) migrate() error {
+	db, err := sql.Open("sqlite3", c.dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open db: %w", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE IF NOT EXISTS schema_version (version INTEGER NOT NULL)"); err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	var version int
+	err = db.QueryRow("SELECT version FROM schema_version").Scan(&version)
+	if err == sql.ErrNoRows {
+		if _, err := db.Exec("INSERT INTO schema_version (version) VALUES (0)"); err != nil {
+			return fmt.Errorf("failed to initialize schema_version: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to read schema_version: %w", err)
+	}
+
+	for ; version < len(migrations); version++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return fmt.Errorf("failed to begin migration %d: %w", version+1, err)
+		}
+		if err := migrations[version](tx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d failed: %w", version+1, err)
+		}
+		if _, err := tx.Exec("UPDATE schema_version SET version = ?", version+1); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record schema version %d: %w", version+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit migration %d: %w", version+1, err)
+		}
+	}
+	return nil
+}
+
+// addColumnIfMissing adds a column to a table unless it already exists.
+// Databases upgraded before schema_version existed may already have the column.
+func addColumnIfMissing(tx *sql.Tx, table, column, columnType string) error {
+	exists, err := hasColumn(tx, table, column)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	if _, err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, columnType)); err != nil {
+		return fmt.Errorf("failed to add %s column: %w", column, err)
+	}
+	return nil
+}
+
+// hasColumn reports whether the given table has a column with the given name
+func hasColumn(tx *sql.Tx, table, column string) (bool, error) {
+	rows, err := tx.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return false, fmt.Errorf("failed to read table info: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var cid, notNull, pk int
+		var name, colType string
+		var defaultValue sql.NullString
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultValue, &pk); err != nil {
+			return false, fmt.Errorf("failed to scan table info: %w", err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	return false, rows.Err()
+}
+
 // Log inserts a new record into the log table with the given check type, result, and parameters
 func (c *DBConnector) Log(checkType string, result bool, params map[string]interface{}) error {
+	return c.LogWithDuration(checkType, result, params, 0)
+}
+
+// LogWithDuration is like Log but also records how long the check took, so slow checks on large files can be found
+func (c *DBConnector) LogWithDuration(checkType string, result bool, params map[string]interface{}, duration time.Duration) error {
+	return c.LogWithSeverity(checkType, result, params, duration, DefaultSeverity)
+}
+
+// LogWithSeverity is like LogWithDuration but also records the check's severity (error, warn, or info),
+// so a warning-level failure can be told apart from a hard error in the history.
+// An empty severity is recorded as DefaultSeverity.
+func (c *DBConnector) LogWithSeverity(checkType string, result bool, params map[string]interface{}, duration time.Duration, severity string) error {
+	if severity == "" {
+		severity = DefaultSeverity
+	}
 	db, err := sql.Open("sqlite3", c.dbPath)
 	if err != nil {
 		return fmt.Errorf("failed to open db: %w", err)
@@ -95,28 +214,77 @@ func (c *DBConnector) Log(checkType string, result bool, params map[string]inter
 	}
 
 	query := `
-	INSERT INTO log (timestamp, data_quality_check_type, result, additional_params)
-	VALUES (?, ?, ?, ?)
+	INSERT INTO log (timestamp, data_quality_check_type, result, additional_params, duration_ms, severity)
+	VALUES (?, ?, ?, ?, ?, ?)
 	`
-	_, err = db.Exec(query, timestamp, checkType, resultInt, additionalParams)
+	_, err = db.Exec(query, timestamp, checkType, resultInt, additionalParams, duration.Milliseconds(), severity)
 	if err != nil {
 		return fmt.Errorf("failed to insert log: %w", err)
 	}
 	return nil
 }
 
-// PrintAllLogs prints all logs to stdout
-func (c *DBConnector) PrintAllLogs() error {
+// LogFilter selects which log entries GetLogs returns and PrintAllLogs prints. The zero value selects every entry.
+type LogFilter struct {
+	// CheckType keeps only entries of one check type, e.g. "is_column_unique"
+	CheckType string
+	// Result keeps only passing (true) or failing (false) entries; nil keeps both
+	Result *bool
+	// Since and Until bound the entry timestamps, inclusively; zero values leave that side unbounded
+	Since time.Time
+	Until time.Time
+	// Limit keeps only the most recent Limit matching entries; 0 keeps all
+	Limit int
+}
+
+// whereClause builds the parameterized WHERE clause (with a leading space, or empty) and its arguments.
+// Timestamps are compared through SQLite's datetime(), which normalizes the stored RFC 3339 offsets to UTC.
+func (f LogFilter) whereClause() (string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+	if f.CheckType != "" {
+		conditions = append(conditions, "data_quality_check_type = ?")
+		args = append(args, f.CheckType)
+	}
+	if f.Result != nil {
+		resultInt := 0
+		if *f.Result {
+			resultInt = 1
+		}
+		conditions = append(conditions, "result = ?")
+		args = append(args, resultInt)
+	}
+	if !f.Since.IsZero() {
+		conditions = append(conditions, "datetime(timestamp) >= datetime(?)")
+		args = append(args, f.Since.Format(time.RFC3339))
+	}
+	if !f.Until.IsZero() {
+		conditions = append(conditions, "datetime(timestamp) <= datetime(?)")
+		args = append(args, f.Until.Format(time.RFC3339))
+	}
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// GetLogs returns the log entries matching filter, oldest first, for programs that consume check history
+// rather than print it. With a Limit, the most recent entries are selected and then returned in id order.
+func (c *DBConnector) GetLogs(filter LogFilter) ([]LogEntry, error) {
 	db, err := sql.Open("sqlite3", c.dbPath)
 	if err != nil {
-		return fmt.Errorf("failed to open db: %w", err)
+		return nil, fmt.Errorf("failed to open db: %w", err)
 	}
 	defer db.Close()
 
-	query := "SELECT id, timestamp, data_quality_check_type, result, additional_params FROM log ORDER BY id"
-	rows, err := db.Query(query)
+	where, args := filter.whereClause()
+	query := "SELECT id, timestamp, data_quality_check_type, result, additional_params, duration_ms, severity FROM log" + where + " ORDER BY id"
+	if filter.Limit > 0 {
+		query = fmt.Sprintf("SELECT * FROM (%s DESC LIMIT %d) ORDER BY id", query, filter.Limit)
+	}
+	rows, err := db.Query(query, args...)
 	if err != nil {
-		return fmt.Errorf("failed to query logs: %w", err)
+		return nil, fmt.Errorf("failed to query logs: %w", err)
 	}
 	defer rows.Close()
 
@@ -125,15 +293,31 @@ func (c *DBConnector) PrintAllLogs() error {
 		var e LogEntry
 		var resultInt int
 		var additionalParams sql.NullString
-		if err := rows.Scan(&e.ID, &e.Timestamp, &e.DataQualityCheckType, &resultInt, &additionalParams); err != nil {
-			return fmt.Errorf("failed to scan row: %w", err)
+		var durationMs sql.NullInt64
+		var severity sql.NullString
+		if err := rows.Scan(&e.ID, &e.Timestamp, &e.DataQualityCheckType, &resultInt, &additionalParams, &durationMs, &severity); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 		e.Result = resultInt != 0
 		if additionalParams.Valid {
 			e.AdditionalParams = additionalParams.String
 		}
+		e.DurationMs = durationMs.Int64
+		e.Severity = DefaultSeverity
+		if severity.Valid && severity.String != "" {
+			e.Severity = severity.String
+		}
 		entries = append(entries, e)
 	}
+	return entries, rows.Err()
+}
+
+// PrintAllLogs prints the logs matching filter to stdout
+func (c *DBConnector) PrintAllLogs(filter LogFilter) error {
+	entries, err := c.GetLogs(filter)
+	if err != nil {
+		return err
+	}
 
 	if len(entries) == 0 {
 		fmt.Println("No log entries found.")
@@ -142,20 +326,70 @@ func (c *DBConnector) PrintAllLogs() error {
 
 	// Format matching Python output
 	// Python: f"{'ID':<5} {'Timestamp':<26} {'Check Type':<35} {'Result':<8} {'Additional Params'}"
-	fmt.Printf("%-5s %-26s %-35s %-8s %s\n", "ID", "Timestamp", "Check Type", "Result", "Additional Params")
-	fmt.Println("------------------------------------------------------------------------------------------------------------------------")
+	fmt.Printf("%-5s %-26s %-35s %-8s %-9s %-12s %s\n", "ID", "Timestamp", "Check Type", "Result", "Severity", "Duration", "Additional Params")
+	fmt.Println("-------------------------------------------------------------------------------------------------------------------------------------")
 
 	for _, e := range entries {
 		resStr := "FAIL"
 		if e.Result {
 			resStr = "PASS"
 		}
-		fmt.Printf("%-5d %-26s %-35s %-8s %s\n", e.ID, e.Timestamp, e.DataQualityCheckType, resStr, e.AdditionalParams)
+		duration := fmt.Sprintf("%dms", e.DurationMs)
+		fmt.Printf("%-5d %-26s %-35s %-8s %-9s %-12s %s\n", e.ID, e.Timestamp, e.DataQualityCheckType, resStr, e.Severity, duration, e.AdditionalParams)
 	}
 
 	return nil
 }
 
+// CheckSummary aggregates the log history of one check type
+type CheckSummary struct {
+	CheckType string  `json:"check_type"`
+	Runs      int     `json:"runs"`
+	Passed    int     `json:"passed"`
+	Failed    int     `json:"failed"`
+	PassRate  float64 `json:"pass_rate"`
+	// LastRun is the timestamp of the most recently logged run
+	LastRun string `json:"last_run"`
+}
+
+// Summarize returns run, pass and fail counts, the pass rate, and the last run timestamp per check type,
+// ordered by check type. The last run is taken from the highest id, since stored timestamps may carry
+// different UTC offsets and do not sort as text.
+func (c *DBConnector) Summarize() ([]CheckSummary, error) {
+	db, err := sql.Open("sqlite3", c.dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open db: %w", err)
+	}
+	defer db.Close()
+
+	query := `
+	SELECT summary.data_quality_check_type, summary.runs, summary.passed, latest.timestamp
+	FROM (
+		SELECT data_quality_check_type, COUNT(*) AS runs, SUM(result) AS passed, MAX(id) AS last_id
+		FROM log
+		GROUP BY data_quality_check_type
+	) AS summary
+	JOIN log AS latest ON latest.id = summary.last_id
+	ORDER BY summary.data_quality_check_type`
+	rows, err := db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize logs: %w", err)
+	}
+	defer rows.Close()
+
+	var summaries []CheckSummary
+	for rows.Next() {
+		var s CheckSummary
+		if err := rows.Scan(&s.CheckType, &s.Runs, &s.Passed, &s.LastRun); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		s.Failed = s.Runs - s.Passed
+		s.PassRate = float64(s.Passed) / float64(s.Runs)
+		summaries = append(summaries, s)
+	}
+	return summaries, rows.Err()
+}
+
 // ClearLogs removes all entries from the log table
 func (c *DBConnector) ClearLogs() error {
 	db, err := sql.Open("sqlite3", c.dbPath)
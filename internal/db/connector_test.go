@@ -209,3 +209,312 @@ func TestClearLogs(t *testing.T) {
 		t.Errorf("Expected 0 logs, got %d", count)
 	}
 }
+
+func TestLogWithDuration(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	connector := NewDBConnector(dbPath)
+
+	if err := connector.LogWithDuration("slow_check", true, nil, 1500*time.Millisecond); err != nil {
+		t.Fatalf("Failed to log: %v", err)
+	}
+
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var durationMs int64
+	if err := db.QueryRow("SELECT duration_ms FROM log").Scan(&durationMs); err != nil {
+		t.Fatal(err)
+	}
+	if durationMs != 1500 {
+		t.Errorf("Expected duration_ms 1500, got %d", durationMs)
+	}
+}
+
+func TestLogWithSeverity(t *testing.T) {
+	connector := NewDBConnector(filepath.Join(t.TempDir(), "test.db"))
+
+	if err := connector.LogWithSeverity("soft_check", false, nil, 0, "warn"); err != nil {
+		t.Fatalf("Failed to log: %v", err)
+	}
+	if err := connector.Log("hard_check", false, nil); err != nil {
+		t.Fatalf("Failed to log: %v", err)
+	}
+
+	entries, err := connector.GetLogs(LogFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || entries[0].Severity != "warn" || entries[1].Severity != DefaultSeverity {
+		t.Errorf("Expected severities warn and %s, got %+v", DefaultSeverity, entries)
+	}
+}
+
+func TestDurationColumnAddedToExistingTable(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+
+	// Create a log table as older versions did, without duration_ms
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = db.Exec(`
+	CREATE TABLE log (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		timestamp TEXT NOT NULL,
+		data_quality_check_type TEXT NOT NULL,
+		result INTEGER NOT NULL,
+		additional_params TEXT
+	)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO log (timestamp, data_quality_check_type, result) VALUES ('2024-01-01T00:00:00Z', 'old_check', 1)"); err != nil {
+		t.Fatal(err)
+	}
+
+	connector := NewDBConnector(dbPath)
+	if err := connector.LogWithDuration("new_check", true, nil, 20*time.Millisecond); err != nil {
+		t.Fatalf("Failed to log after upgrade: %v", err)
+	}
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM log").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("Expected the old entry to be kept alongside the new one, got %d entries", count)
+	}
+
+	var oldDuration sql.NullInt64
+	if err := db.QueryRow("SELECT duration_ms FROM log WHERE data_quality_check_type = 'old_check'").Scan(&oldDuration); err != nil {
+		t.Fatal(err)
+	}
+	if oldDuration.Valid {
+		t.Errorf("Expected old entry to have a NULL duration, got %d", oldDuration.Int64)
+	}
+
+	if err := connector.PrintAllLogs(LogFilter{}); err != nil {
+		t.Errorf("PrintAllLogs failed on upgraded table: %v", err)
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	readVersion := func(t *testing.T, db *sql.DB) int {
+		t.Helper()
+		var version int
+		if err := db.QueryRow("SELECT version FROM schema_version").Scan(&version); err != nil {
+			t.Fatal(err)
+		}
+		return version
+	}
+
+	t.Run("NewDatabaseIsCurrent", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "test.db")
+		NewDBConnector(dbPath)
+
+		db, err := sql.Open("sqlite3", dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		if got := readVersion(t, db); got != len(migrations) {
+			t.Errorf("Expected schema version %d, got %d", len(migrations), got)
+		}
+	})
+
+	t.Run("OldTableIsUpgraded", func(t *testing.T) {
+		dbPath := filepath.Join(t.TempDir(), "test.db")
+		db, err := sql.Open("sqlite3", dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		_, err = db.Exec(`
+		CREATE TABLE log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			timestamp TEXT NOT NULL,
+			data_quality_check_type TEXT NOT NULL,
+			result INTEGER NOT NULL,
+			additional_params TEXT
+		)`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		connector := &DBConnector{dbPath: dbPath}
+		if err := connector.migrate(); err != nil {
+			t.Fatalf("migrate failed: %v", err)
+		}
+		// Running again must be a no-op
+		if err := connector.migrate(); err != nil {
+			t.Fatalf("second migrate failed: %v", err)
+		}
+
+		if got := readVersion(t, db); got != len(migrations) {
+			t.Errorf("Expected schema version %d, got %d", len(migrations), got)
+		}
+		var rows int
+		if err := db.QueryRow("SELECT COUNT(*) FROM schema_version").Scan(&rows); err != nil {
+			t.Fatal(err)
+		}
+		if rows != 1 {
+			t.Errorf("Expected a single schema_version row, got %d", rows)
+		}
+		if _, err := db.Exec("SELECT duration_ms FROM log"); err != nil {
+			t.Errorf("Expected duration_ms column after migration: %v", err)
+		}
+	})
+
+	t.Run("ColumnAddedBeforeVersioning", func(t *testing.T) {
+		// A database upgraded before schema_version existed already has duration_ms
+		dbPath := filepath.Join(t.TempDir(), "test.db")
+		db, err := sql.Open("sqlite3", dbPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		_, err = db.Exec(`
+		CREATE TABLE log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			timestamp TEXT NOT NULL,
+			data_quality_check_type TEXT NOT NULL,
+			result INTEGER NOT NULL,
+			additional_params TEXT,
+			duration_ms INTEGER
+		)`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		connector := &DBConnector{dbPath: dbPath}
+		if err := connector.migrate(); err != nil {
+			t.Fatalf("migrate failed: %v", err)
+		}
+		if got := readVersion(t, db); got != len(migrations) {
+			t.Errorf("Expected schema version %d, got %d", len(migrations), got)
+		}
+	})
+}
+
+func TestGetLogs(t *testing.T) {
+	tempDir := t.TempDir()
+	dbPath := filepath.Join(tempDir, "test.db")
+	connector := NewDBConnector(dbPath)
+
+	for _, entry := range []struct {
+		checkType string
+		result    bool
+	}{
+		{"is_column_unique", true},
+		{"is_column_unique", false},
+		{"is_column_not_null", false},
+		{"is_column_unique", false},
+	} {
+		if err := connector.Log(entry.checkType, entry.result, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Backdate the first entry, written with a non-UTC offset, to test the time range
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := db.Exec("UPDATE log SET timestamp = '2020-01-01T02:00:00+02:00' WHERE id = 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	failed := false
+	ids := func(filter LogFilter) []int {
+		entries, err := connector.GetLogs(filter)
+		if err != nil {
+			t.Fatalf("GetLogs(%+v) failed: %v", filter, err)
+		}
+		var out []int
+		for _, e := range entries {
+			out = append(out, e.ID)
+		}
+		return out
+	}
+	entries, err := connector.GetLogs(LogFilter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].DataQualityCheckType != "is_column_unique" || entries[0].Result || entries[0].Timestamp == "" {
+		t.Errorf("Expected the most recent entry to be a failed is_column_unique, got %+v", entries)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		filter LogFilter
+		want   []int
+	}{
+		{"All", LogFilter{}, []int{1, 2, 3, 4}},
+		{"CheckType", LogFilter{CheckType: "is_column_unique"}, []int{1, 2, 4}},
+		{"Failures", LogFilter{Result: &failed}, []int{2, 3, 4}},
+		{"CheckTypeFailures", LogFilter{CheckType: "is_column_unique", Result: &failed}, []int{2, 4}},
+		{"Since", LogFilter{Since: time.Date(2020, 1, 1, 0, 30, 0, 0, time.UTC)}, []int{2, 3, 4}},
+		{"Until", LogFilter{Until: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}, []int{1}},
+		{"LimitKeepsMostRecent", LogFilter{Limit: 2}, []int{3, 4}},
+		{"LimitWithFilter", LogFilter{CheckType: "is_column_unique", Limit: 2}, []int{2, 4}},
+	} {
+		got := ids(tc.filter)
+		if len(got) != len(tc.want) {
+			t.Errorf("%s: got ids %v, want %v", tc.name, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%s: got ids %v, want %v", tc.name, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	connector := NewDBConnector(filepath.Join(t.TempDir(), "test.db"))
+
+	summaries, err := connector.Summarize()
+	if err != nil || len(summaries) != 0 {
+		t.Fatalf("Expected no summaries for an empty log, got %v (err: %v)", summaries, err)
+	}
+
+	for _, entry := range []struct {
+		checkType string
+		result    bool
+	}{
+		{"is_column_unique", true},
+		{"is_column_not_null", false},
+		{"is_column_unique", false},
+		{"is_column_unique", true},
+		{"is_column_unique", true},
+	} {
+		if err := connector.Log(entry.checkType, entry.result, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	summaries, err = connector.Summarize()
+	if err != nil {
+		t.Fatalf("Summarize failed: %v", err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 check types, got %+v", summaries)
+	}
+	notNull, unique := summaries[0], summaries[1]
+	if notNull.CheckType != "is_column_not_null" || notNull.Runs != 1 || notNull.Failed != 1 || notNull.PassRate != 0 {
+		t.Errorf("Unexpected not-null summary: %+v", notNull)
+	}
+	if unique.CheckType != "is_column_unique" || unique.Runs != 4 || unique.Passed != 3 || unique.Failed != 1 || unique.PassRate != 0.75 {
+		t.Errorf("Unexpected unique summary: %+v", unique)
+	}
+	if unique.LastRun == "" {
+		t.Error("Expected a last run timestamp")
+	}
+}
@@ -0,0 +1,757 @@
+package suite
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/josephmachado/data_quality_checker/internal/checker"
+)
+
+// checkFunc reads a spec's params and runs the matching checker method
+type checkFunc func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error)
+
+// registry maps suite check types to checker methods.
+// Type names are the CLI command names without the "check-" prefix, and param keys
+// are the command's flag names, so a CLI invocation translates directly into a suite entry.
+var registry = map[string]checkFunc{
+	"unique": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnUnique(spec.Data, column)
+	},
+	"not-null": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxFailFraction := p.floatOr("max-fail-fraction", 0)
+		if p.err != nil {
+			return false, p.err
+		}
+		if maxFailFraction > 0 {
+			return c.IsColumnNotNullWithTolerance(spec.Data, column, maxFailFraction)
+		}
+		return c.IsColumnNotNull(spec.Data, column)
+	},
+	"enum": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		values := p.strs("enum-values")
+		ignoreCase := p.boolOr("ignore-case", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnEnumIgnoreCase(spec.Data, column, values, ignoreCase)
+	},
+	"references": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		reference := p.str("reference")
+		joinKeys := p.strs("join-keys")
+		emptyAsNull := p.boolOr("empty-as-null", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreTablesReferentialIntegralEmptyAsNull(spec.Data, reference, joinKeys, emptyAsNull)
+	},
+	"column-exists": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnInData(spec.Data, column)
+	},
+	"between": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		min := p.float("min")
+		max := p.float("max")
+		maxFailFraction := p.floatOr("max-fail-fraction", 0)
+		if p.err != nil {
+			return false, p.err
+		}
+		if maxFailFraction > 0 {
+			return c.IsColumnBetweenWithTolerance(spec.Data, column, min, max, maxFailFraction)
+		}
+		return c.IsColumnBetween(spec.Data, column, min, max)
+	},
+	"regex": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		regex := p.str("regex")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnRegexMatch(spec.Data, column, regex)
+	},
+	"type": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		targetType := p.str("type")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnOfType(spec.Data, column, targetType)
+	},
+	"numeric-typed": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNumericTyped(spec.Data, column)
+	},
+	"length": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		min := p.int("min")
+		max := p.int("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnLengthBetween(spec.Data, column, min, max)
+	},
+	"max":    aggregateCheck((*checker.DataQualityChecker).IsColumnMaxBetween),
+	"min":    aggregateCheck((*checker.DataQualityChecker).IsColumnMinBetween),
+	"mean":   aggregateCheck((*checker.DataQualityChecker).IsColumnMeanBetween),
+	"median": aggregateCheck((*checker.DataQualityChecker).IsColumnMedianBetween),
+	"sum":    aggregateCheck((*checker.DataQualityChecker).IsColumnSumBetween),
+	"stddev": aggregateCheck((*checker.DataQualityChecker).IsColumnStdDevBetween),
+	"quantile": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		quantile := p.floatOr("quantile", 0.5)
+		min := p.float("min")
+		max := p.float("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnQuantileBetween(spec.Data, column, quantile, min, max)
+	},
+	"date-format": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		format := p.str("format")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDateFormat(spec.Data, column, format)
+	},
+	"row-count": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		min := p.int("min")
+		max := p.int("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsTableRowCountBetween(spec.Data, int64(min), int64(max))
+	},
+	"col-count": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		min := p.int("min")
+		max := p.int("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsTableColumnCountBetween(spec.Data, min, max)
+	},
+	"not-in-set": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		values := p.strs("values")
+		ignoreCase := p.boolOr("ignore-case", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNotInSetIgnoreCase(spec.Data, column, values, ignoreCase)
+	},
+	"increasing": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		orderBy := p.strOr("order-by", "")
+		strict := p.boolOr("strict", true)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnMonotonic(spec.Data, column, "increasing", strict, orderBy)
+	},
+	"decreasing": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		orderBy := p.strOr("order-by", "")
+		strict := p.boolOr("strict", true)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnMonotonic(spec.Data, column, "decreasing", strict, orderBy)
+	},
+	"date-parseable": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDateParseable(spec.Data, column)
+	},
+	"pair-equal": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		col1 := p.str("col1")
+		col2 := p.str("col2")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreColumnPairsEqual(spec.Data, col1, col2)
+	},
+	"distinct-in-set": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		values := p.strs("values")
+		ignoreCase := p.boolOr("ignore-case", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreDistinctValuesInSetIgnoreCase(spec.Data, column, values, ignoreCase)
+	},
+	"group-sum": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		valueCol := p.str("value-column")
+		groupBy := p.strs("group-by")
+		reference := p.str("reference")
+		refKey := p.str("reference-key")
+		refValue := p.str("reference-value")
+		tolerance := p.floatOr("tolerance", 0)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.GroupSumMatchesReference(spec.Data, valueCol, groupBy, reference, refKey, refValue, tolerance)
+	},
+	"checksum": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		algo := p.strOr("algo", "luhn")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidChecksum(spec.Data, column, algo)
+	},
+	"distinct-stable": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		baseline := p.str("baseline")
+		column := p.str("column")
+		minRatio := p.floatOr("min-ratio", 0.5)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.DistinctCountStable(spec.Data, baseline, column, minRatio)
+	},
+	"list-elements": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		delimiter := p.strOr("delimiter", ",")
+		min := p.float("min")
+		max := p.float("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.ListElementsBetween(spec.Data, column, delimiter, min, max)
+	},
+	"git-sha": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		full := p.boolOr("full", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidGitSHA(spec.Data, column, full)
+	},
+	"version-ranges": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		entity := p.str("entity")
+		from := p.str("from")
+		to := p.str("to")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.VersionRangesContiguous(spec.Data, entity, from, to)
+	},
+	"json-safe": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnJSONSafe(spec.Data, column)
+	},
+	"no-dup-columns": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		return c.HasNoDuplicateColumns(spec.Data)
+	},
+	"sig-figs": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		max := p.int("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnSignificantFiguresAtMost(spec.Data, column, max)
+	},
+	"paths-exist": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		checkURLs := p.boolOr("check-urls", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AllPathsExist(spec.Data, column, checkURLs)
+	},
+	"conditional-enum": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		typeCol := p.str("type-column")
+		valueCol := p.str("value-column")
+		mapping := p.mapping("mapping")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.ConditionalEnum(spec.Data, typeCol, valueCol, mapping)
+	},
+	"json-pointer": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidJSONPointer(spec.Data, column)
+	},
+	"churn": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		otherPath := p.str("other")
+		key := p.str("key")
+		maxChurn := p.float("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.RowChurnBelow(spec.Data, otherPath, key, maxChurn)
+	},
+	"word-count": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		min := p.float("min")
+		max := p.float("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AvgWordCountBetween(spec.Data, column, min, max)
+	},
+	"unique-composite": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		columns := p.strs("columns")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreColumnsUnique(spec.Data, columns)
+	},
+	"allocation-range": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		idCol := p.str("id-column")
+		sourceCol := p.str("source-column")
+		ranges := p.ranges("ranges")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnInAllocationRange(spec.Data, idCol, sourceCol, ranges)
+	},
+	"rule": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		if spec.Rule == nil {
+			return false, fmt.Errorf("rule check requires a rule")
+		}
+		predicate, err := spec.Rule.Compile()
+		if err != nil {
+			return false, err
+		}
+		return c.AllRowsSatisfy(spec.Data, predicate)
+	},
+	"distinct-count": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		min := int64(p.int("min"))
+		max := int64(p.int("max"))
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDistinctCountBetween(spec.Data, column, min, max)
+	},
+	"granularity": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		granularity := p.str("granularity")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDateGranularity(spec.Data, column, granularity)
+	},
+	"value-rate": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		value := p.str("value")
+		expected := p.float("expected")
+		tolerance := p.float("tolerance")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.ValueRateNear(spec.Data, column, value, expected, tolerance)
+	},
+	"null-fraction": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxFraction := p.float("max-fraction")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNullFractionBelow(spec.Data, column, maxFraction)
+	},
+	"reference-attribute": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		joinKey := p.str("join-key")
+		refPath := p.str("reference")
+		refJoinKey := p.strOr("reference-key", joinKey)
+		refAttr := p.str("attribute")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.ReferenceAttributeNotNull(spec.Data, joinKey, refPath, refJoinKey, refAttr)
+	},
+	"sums-to": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		target := p.float("target")
+		tolerance := p.floatOr("tolerance", 0)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.ColumnSumsTo(spec.Data, column, target, tolerance)
+	},
+	"no-long-runs": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		orderBy := p.strOr("order-by", "")
+		maxRun := p.int("max-run")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.NoLongRunsOfIdenticalValues(spec.Data, column, orderBy, maxRun)
+	},
+	"identical": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		otherPath := p.str("other")
+		keys := p.strsOr("keys")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.FilesAreIdentical(spec.Data, otherPath, keys)
+	},
+	"no-near-zero": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		epsilon := p.float("epsilon")
+		includeZero := p.boolOr("include-zero", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.HasNoNearZeroValuesIncludingZero(spec.Data, column, epsilon, includeZero)
+	},
+	"epoch": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		unit := p.strOr("unit", "s")
+		minYear := p.int("min-year")
+		maxYear := p.int("max-year")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidEpoch(spec.Data, column, unit, minYear, maxYear)
+	},
+	"no-duplicate-rows": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		return c.HasNoDuplicateRows(spec.Data)
+	},
+	"monotonic-after": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		orderBy := p.strOr("order-by", "")
+		threshold := p.float("threshold")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsMonotonicAfterThreshold(spec.Data, column, orderBy, threshold)
+	},
+	"compare": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		col1 := p.str("col1")
+		op := p.str("op")
+		col2 := p.str("col2")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnComparison(spec.Data, col1, op, col2)
+	},
+	"quoting": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		return c.HasWellFormedQuoting(spec.Data)
+	},
+	"conditional": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		if spec.When == nil || spec.Then == nil {
+			return false, fmt.Errorf("conditional check requires a when and a then rule")
+		}
+		when, err := spec.When.Compile()
+		if err != nil {
+			return false, fmt.Errorf("when: %w", err)
+		}
+		then, err := spec.Then.Compile()
+		if err != nil {
+			return false, fmt.Errorf("then: %w", err)
+		}
+		return c.IsConditionTrue(spec.Data, when, then)
+	},
+	"ddl": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		ddlFile := p.str("ddl-file")
+		if p.err != nil {
+			return false, p.err
+		}
+		ddl, err := os.ReadFile(ddlFile)
+		if err != nil {
+			return false, fmt.Errorf("failed to read DDL file: %w", err)
+		}
+		return c.MatchesDDL(spec.Data, string(ddl))
+	},
+	"email": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidEmail(spec.Data, column)
+	},
+	"uuid": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidUUID(spec.Data, column)
+	},
+	"null-stable": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		baselinePath := p.str("baseline")
+		column := p.str("column")
+		maxIncrease := p.floatOr("max-increase", 0.05)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.NullRateStable(spec.Data, baselinePath, column, maxIncrease)
+	},
+	"schema": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		columns := p.strs("columns")
+		strict := p.boolOr("strict", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.DoesSchemaMatch(spec.Data, columns, strict)
+	},
+	"numeric-locale": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		locale := p.str("locale")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNumericLocale(spec.Data, column, locale)
+	},
+	"schema-types": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		types := p.columnTypes("types")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.DoesSchemaTypesMatch(spec.Data, types)
+	},
+	"ts-precision": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnConsistentPrecision(spec.Data, column)
+	},
+	"row-count-equal": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		expected := int64(p.int("expected"))
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsTableRowCountEqual(spec.Data, expected)
+	},
+	"in-any-reference": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		refs := p.refSpecs("references")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsInAnyReference(spec.Data, column, refs)
+	},
+	"row-count-match": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		refPath := p.str("reference")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreRowCountsEqual(spec.Data, refPath)
+	},
+	"positive": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnPositive(spec.Data, column)
+	},
+	"non-negative": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNonNegative(spec.Data, column)
+	},
+	"psi": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		baselinePath := p.str("baseline")
+		column := p.str("column")
+		maxPSI := p.floatOr("max-psi", 0.2)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.PopulationStabilityIndexBelow(spec.Data, baselinePath, column, maxPSI)
+	},
+	"trimmed": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnTrimmed(spec.Data, column)
+	},
+	"not-blank": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnNotBlank(spec.Data, column)
+	},
+	"enum-suggest": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		enumValues := p.strs("enum-values")
+		maxEditDistance := p.intOr("max-edit-distance", 2)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnEnumWithSuggestions(spec.Data, column, enumValues, maxEditDistance)
+	},
+	"rolling": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("value")
+		orderBy := p.strOr("order-by", "")
+		window := p.int("window")
+		maxDeviation := p.float("max-deviation")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.WithinRollingBounds(spec.Data, column, orderBy, window, maxDeviation)
+	},
+	"balanced-assignment": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxImbalance := p.floatOr("max-imbalance", 0.05)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.DistributionIsBalanced(spec.Data, column, maxImbalance)
+	},
+	"glob-schema": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		return c.GlobSchemaConsistent(spec.Data)
+	},
+	"grammar": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		grammarPath := p.str("grammar")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnMatchesGrammar(spec.Data, column, grammarPath)
+	},
+	"in-reference": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		reference := p.str("reference")
+		referenceColumn := p.strOr("reference-column", column)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnSubsetOfReference(spec.Data, column, reference, referenceColumn)
+	},
+	"zscore": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxZ := p.floatOr("max-z", 3)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnWithinZScore(spec.Data, column, maxZ)
+	},
+	"iqr": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		k := p.floatOr("k", 1.5)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnWithinIQR(spec.Data, column, k)
+	},
+	"mutually-exclusive": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		col1 := p.str("col1")
+		col2 := p.str("col2")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.AreColumnsMutuallyExclusive(spec.Data, col1, col2)
+	},
+	"at-least-one": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		columns := p.strs("columns")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsAtLeastOneNotNull(spec.Data, columns)
+	},
+	"json": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnValidJSON(spec.Data, column)
+	},
+	"whole-number": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnIsWholeNumber(spec.Data, column)
+	},
+	"scale": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxScale := p.intOr("max-scale", 2)
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnScaleAtMost(spec.Data, column, maxScale)
+	},
+	"prefix": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		prefix := p.str("prefix")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnPrefixed(spec.Data, column, prefix)
+	},
+	"suffix": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		suffix := p.str("suffix")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnSuffixed(spec.Data, column, suffix)
+	},
+	"contains": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		substr := p.str("substring")
+		negate := p.boolOr("negate", false)
+		if p.err != nil {
+			return false, p.err
+		}
+		if negate {
+			return c.IsColumnNotContains(spec.Data, column, substr)
+		}
+		return c.IsColumnContains(spec.Data, column, substr)
+	},
+	"date-between": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		minDate := p.date("min")
+		maxDate := p.date("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDateBetween(spec.Data, column, minDate, maxDate)
+	},
+	"date-not-future": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		asOf := p.timeOr("as-of", time.Now())
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsColumnDateNotInFutureAsOf(spec.Data, column, asOf)
+	},
+	"freshness": func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		maxAge := p.duration("max-age")
+		asOf := p.timeOr("as-of", time.Now())
+		if p.err != nil {
+			return false, p.err
+		}
+		return c.IsDataFreshAsOf(spec.Data, column, maxAge, asOf)
+	},
+}
+
+// aggregateCheck adapts the IsColumn<Aggregate>Between family, which share a (column, min, max) signature
+func aggregateCheck(method func(*checker.DataQualityChecker, string, string, float64, float64) (bool, error)) checkFunc {
+	return func(c *checker.DataQualityChecker, spec CheckSpec, p *params) (bool, error) {
+		column := p.str("column")
+		min := p.float("min")
+		max := p.float("max")
+		if p.err != nil {
+			return false, p.err
+		}
+		return method(c, spec.Data, column, min, max)
+	}
+}
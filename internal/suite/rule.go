@@ -0,0 +1,138 @@
+package suite
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rule is a node of a composite row-level rule. Exactly one of And, Or, Not or Column must be set:
+// And/Or/Not combine child rules, and Column (with Op and Value/Values) is a primitive condition.
+//
+//	rule:
+//	  or:
+//	    - and:
+//	        - {column: status, op: eq, value: shipped}
+//	        - {column: shipped_at, op: not_null}
+//	    - {column: status, op: in, values: [pending, cancelled]}
+type Rule struct {
+	And []Rule `yaml:"and"`
+	Or  []Rule `yaml:"or"`
+	Not *Rule  `yaml:"not"`
+
+	Column string        `yaml:"column"`
+	Op     string        `yaml:"op"`
+	Value  interface{}   `yaml:"value"`
+	Values []interface{} `yaml:"values"`
+}
+
+// comparisonOps maps rule comparison operators to SQL
+var comparisonOps = map[string]string{
+	"eq":  "=",
+	"ne":  "!=",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+// Compile turns the rule tree into a single SQL boolean predicate.
+// Column names are quoted as identifiers and values as literals, so the result is safe to run
+// with checker.AllRowsSatisfy even though rule files come from users.
+func (r Rule) Compile() (string, error) {
+	set := 0
+	for _, isSet := range []bool{len(r.And) > 0, len(r.Or) > 0, r.Not != nil, r.Column != ""} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return "", fmt.Errorf("rule must set exactly one of and, or, not, or column")
+	}
+
+	switch {
+	case len(r.And) > 0:
+		return compileGroup(r.And, " AND ")
+	case len(r.Or) > 0:
+		return compileGroup(r.Or, " OR ")
+	case r.Not != nil:
+		inner, err := r.Not.Compile()
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + inner + ")", nil
+	}
+	return r.compileCondition()
+}
+
+// compileGroup compiles child rules and joins them with the given boolean operator
+func compileGroup(rules []Rule, operator string) (string, error) {
+	parts := make([]string, len(rules))
+	for i, child := range rules {
+		predicate, err := child.Compile()
+		if err != nil {
+			return "", err
+		}
+		parts[i] = "(" + predicate + ")"
+	}
+	return strings.Join(parts, operator), nil
+}
+
+// compileCondition compiles a primitive column condition
+func (r Rule) compileCondition() (string, error) {
+	col := quoteIdent(r.Column)
+	if sqlOp, ok := comparisonOps[r.Op]; ok {
+		if r.Value == nil {
+			return "", fmt.Errorf("rule on column %q: op %q requires a value", r.Column, r.Op)
+		}
+		return fmt.Sprintf("%s %s %s", col, sqlOp, sqlValue(r.Value)), nil
+	}
+
+	switch r.Op {
+	case "null":
+		return col + " IS NULL", nil
+	case "not_null":
+		return col + " IS NOT NULL", nil
+	case "in", "not_in":
+		if len(r.Values) == 0 {
+			return "", fmt.Errorf("rule on column %q: op %q requires values", r.Column, r.Op)
+		}
+		values := make([]string, len(r.Values))
+		for i, v := range r.Values {
+			values[i] = sqlValue(v)
+		}
+		keyword := "IN"
+		if r.Op == "not_in" {
+			keyword = "NOT IN"
+		}
+		return fmt.Sprintf("%s %s (%s)", col, keyword, strings.Join(values, ", ")), nil
+	case "regex":
+		if r.Value == nil {
+			return "", fmt.Errorf("rule on column %q: op %q requires a value", r.Column, r.Op)
+		}
+		return fmt.Sprintf("regexp_matches(CAST(%s AS VARCHAR), %s)", col, quoteLiteral(fmt.Sprint(r.Value))), nil
+	}
+	return "", fmt.Errorf("rule on column %q: unknown op %q", r.Column, r.Op)
+}
+
+// sqlValue renders a YAML scalar as a SQL literal: numbers and booleans as-is, everything else as a quoted string
+func sqlValue(v interface{}) string {
+	switch v := v.(type) {
+	case int, int64, float64:
+		return fmt.Sprint(v)
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	}
+	return quoteLiteral(fmt.Sprint(v))
+}
+
+// quoteIdent and quoteLiteral mirror the checker's quoting so compiled predicates cannot break out of the query
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,110 @@
+package suite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRuleCompile(t *testing.T) {
+	s, err := ParseSuite([]byte(`
+checks:
+  - type: rule
+    data: orders.csv
+    rule:
+      or:
+        - and:
+            - {column: status, op: eq, value: shipped}
+            - {column: shipped_at, op: not_null}
+        - {column: status, op: in, values: [pending, "it's late"]}
+`))
+	if err != nil {
+		t.Fatalf("ParseSuite failed: %v", err)
+	}
+
+	predicate, err := s.Checks[0].Rule.Compile()
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	want := `(("status" = 'shipped') AND ("shipped_at" IS NOT NULL)) OR ("status" IN ('pending', 'it''s late'))`
+	if predicate != want {
+		t.Errorf("Unexpected predicate:\n got: %s\nwant: %s", predicate, want)
+	}
+
+	invalid := []Rule{
+		{},
+		{Column: "a", Op: "eq"},
+		{Column: "a", Op: "like", Value: "x"},
+		{Column: "a", Op: "eq", Value: 1, And: []Rule{{Column: "b", Op: "null"}}},
+	}
+	for _, r := range invalid {
+		if _, err := r.Compile(); err == nil {
+			t.Errorf("Expected compile error for %+v", r)
+		}
+	}
+}
+
+func TestRuleCheck(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "orders.csv")
+	content := "status,shipped_at,qty\nshipped,2024-01-02,3\npending,,1\ncancelled,,0\n"
+	if err := os.WriteFile(data, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// valid if (shipped and shipped_at set) or (not shipped and qty between 0 and 1)
+	rule := &Rule{Or: []Rule{
+		{And: []Rule{
+			{Column: "status", Op: "eq", Value: "shipped"},
+			{Column: "shipped_at", Op: "not_null"},
+		}},
+		{And: []Rule{
+			{Not: &Rule{Column: "status", Op: "eq", Value: "shipped"}},
+			{Column: "qty", Op: "lte", Value: 1},
+		}},
+	}}
+	passed, err := RunCheck(c, CheckSpec{Type: "rule", Data: data, Rule: rule})
+	if err != nil {
+		t.Fatalf("Rule check failed: %v", err)
+	}
+	if !passed {
+		t.Error("Expected every row to satisfy the composite rule")
+	}
+
+	rule.Or[1].And[1] = Rule{Column: "qty", Op: "eq", Value: 0}
+	passed, _ = RunCheck(c, CheckSpec{Type: "rule", Data: data, Rule: rule})
+	if passed {
+		t.Error("Expected the pending row with qty 1 to fail the tightened rule")
+	}
+}
+
+func TestConditionalCheck(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "orders.csv")
+	content := "status,shipped_at\nshipped,2024-01-02\nshipped,\npending,\n"
+	if err := os.WriteFile(data, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := ParseSuite([]byte(`
+checks:
+  - type: conditional
+    data: ` + data + `
+    when: {column: status, op: eq, value: shipped}
+    then: {column: shipped_at, op: not_null}
+`))
+	if err != nil {
+		t.Fatalf("ParseSuite failed: %v", err)
+	}
+	passed, err := RunCheck(c, s.Checks[0])
+	if err != nil {
+		t.Fatalf("Conditional check failed: %v", err)
+	}
+	if passed {
+		t.Error("Expected the shipped row without a ship date to fail")
+	}
+
+	if _, err := RunCheck(c, CheckSpec{Type: "conditional", Data: data, When: s.Checks[0].When}); err == nil {
+		t.Error("Expected error for a conditional check without a then rule")
+	}
+}
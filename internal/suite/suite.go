@@ -0,0 +1,546 @@
+package suite
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/josephmachado/data_quality_checker/internal/checker"
+	"gopkg.in/yaml.v3"
+)
+
+// CheckSpec describes a single check entry of a suite file
+type CheckSpec struct {
+	Name   string `yaml:"name"`
+	Type   string `yaml:"type"`
+	Data   string `yaml:"data"`
+	Column string `yaml:"column"`
+	// Filter is a SQL predicate scoping the check to matching rows of Data (see checker.ScanOptions.Filter)
+	Filter string                 `yaml:"filter"`
+	Params map[string]interface{} `yaml:"params"`
+	// Rule is the composite condition evaluated by the "rule" check type
+	Rule *Rule `yaml:"rule"`
+	// When and Then are the condition and consequence of the "conditional" check type
+	When *Rule `yaml:"when"`
+	Then *Rule `yaml:"then"`
+	// Weight is the check's share of a data quality score (see ScoreChecks); 0 means the default weight of 1
+	Weight float64 `yaml:"weight"`
+	// Severity is error, warn, or info, defaulting to the checker's severity; only error-severity failures fail the suite
+	Severity string `yaml:"severity"`
+}
+
+// Suite is a parsed suite file: an ordered list of checks to run
+type Suite struct {
+	// Data is the default data path of checks that do not set their own
+	Data   string      `yaml:"data"`
+	Checks []CheckSpec `yaml:"checks"`
+}
+
+// Result holds the outcome of running one CheckSpec
+type Result struct {
+	Spec   CheckSpec
+	Passed bool
+	Err    error
+	// ErrorCount is the error_count the check logged, or nil if it logged none
+	ErrorCount *int64
+	// Severity is the severity the check ran with
+	Severity checker.Severity
+}
+
+// Label returns the spec's name, falling back to its type when no name was given
+func (s CheckSpec) Label() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Type
+}
+
+// LoadSuite reads and parses a YAML suite file.
+// Every entry must have a type and a data path; unknown types are rejected up front
+// so that a typo is reported before any check runs.
+func LoadSuite(path string) (*Suite, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read suite file: %w", err)
+	}
+	return ParseSuite(content)
+}
+
+// SuiteFile is one suite file of a directory loaded by LoadSuiteDir.
+// Err holds the file's read or parse error, so that one broken file does not stop the others from running.
+type SuiteFile struct {
+	Path  string
+	Suite *Suite
+	Err   error
+}
+
+// LoadSuiteDir loads every *.yaml and *.yml suite file directly inside dir, in name order,
+// for organizing checks as one suite file per dataset. It fails only if dir holds no suite files.
+func LoadSuiteDir(dir string) ([]SuiteFile, error) {
+	var paths []string
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, matches...)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no suite files (*.yaml or *.yml) found in %s", dir)
+	}
+	sort.Strings(paths)
+
+	files := make([]SuiteFile, len(paths))
+	for i, path := range paths {
+		s, err := LoadSuite(path)
+		files[i] = SuiteFile{Path: path, Suite: s, Err: err}
+	}
+	return files, nil
+}
+
+// ParseSuite parses YAML suite content into a Suite
+func ParseSuite(content []byte) (*Suite, error) {
+	var s Suite
+	if err := yaml.Unmarshal(content, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse suite file: %w", err)
+	}
+	if len(s.Checks) == 0 {
+		return nil, fmt.Errorf("suite file defines no checks")
+	}
+	for i, spec := range s.Checks {
+		if spec.Type == "" {
+			return nil, fmt.Errorf("check %d: missing type", i+1)
+		}
+		if _, ok := registry[spec.Type]; !ok {
+			return nil, fmt.Errorf("check %d: unknown type %q", i+1, spec.Type)
+		}
+		if spec.Data == "" {
+			if s.Data == "" {
+				return nil, fmt.Errorf("check %d (%s): missing data", i+1, spec.Label())
+			}
+			s.Checks[i].Data = s.Data
+		}
+		if spec.Weight < 0 {
+			return nil, fmt.Errorf("check %d (%s): weight must not be negative", i+1, spec.Label())
+		}
+		if _, err := checker.ParseSeverity(spec.Severity); err != nil {
+			return nil, fmt.Errorf("check %d (%s): %w", i+1, spec.Label(), err)
+		}
+	}
+	return &s, nil
+}
+
+// RunSuite executes every check of the suite against the given checker.
+// A check that errors is recorded in its Result and does not stop the remaining checks.
+func RunSuite(c *checker.DataQualityChecker, s *Suite) []Result {
+	results := make([]Result, 0, len(s.Checks))
+	for _, spec := range s.Checks {
+		severity := c.Severity()
+		if spec.Severity != "" {
+			severity = checker.Severity(spec.Severity)
+		}
+		passed, err := RunCheck(c, spec)
+		result := Result{Spec: spec, Passed: passed, Err: err, Severity: severity}
+		if count, ok := c.LastErrorCount(); ok && err == nil {
+			result.ErrorCount = &count
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// RunCheck dispatches a single spec to the checker method registered for its type.
+// A spec's filter replaces the checker's own for the duration of the check, and its severity is logged with the result.
+func RunCheck(c *checker.DataQualityChecker, spec CheckSpec) (bool, error) {
+	fn, ok := registry[spec.Type]
+	if !ok {
+		return false, fmt.Errorf("unknown check type %q", spec.Type)
+	}
+	if spec.Filter != "" {
+		original := c.ScanOptions()
+		scoped := original
+		scoped.Filter = spec.Filter
+		if err := c.SetScanOptions(scoped); err != nil {
+			return false, err
+		}
+		defer c.SetScanOptions(original)
+	}
+	if spec.Severity != "" {
+		defer c.SetSeverity(c.Severity())
+		if err := c.SetSeverity(checker.Severity(spec.Severity)); err != nil {
+			return false, err
+		}
+	}
+	p := &params{spec: spec}
+	return fn(c, spec, p)
+}
+
+// ScoreChecks adapts the suite's checks for checker.Score, running each through RunCheck with its weight
+func ScoreChecks(c *checker.DataQualityChecker, s *Suite) []checker.Check {
+	checks := make([]checker.Check, len(s.Checks))
+	for i, spec := range s.Checks {
+		weight := spec.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		checks[i] = checker.Check{
+			Name:   spec.Label(),
+			Weight: weight,
+			Run:    func() (bool, error) { return RunCheck(c, spec) },
+		}
+	}
+	return checks
+}
+
+// AllPassed reports whether every result passed without error
+func AllPassed(results []Result) bool {
+	for _, r := range results {
+		if r.Err != nil || !r.Passed {
+			return false
+		}
+	}
+	return true
+}
+
+// Blocking reports whether the result should fail a pipeline: it errored, or it failed with error severity.
+// Failures of warn and info checks are reported but tolerated.
+func (r Result) Blocking() bool {
+	return r.Err != nil || (!r.Passed && r.Severity == checker.SeverityError)
+}
+
+// params reads typed values out of a CheckSpec's params map.
+// The first missing or malformed value is remembered in err so that a check
+// can read all its arguments and test for failure once.
+type params struct {
+	spec CheckSpec
+	err  error
+}
+
+// lookup returns the raw value for key; "column" falls back to the spec's column field
+func (p *params) lookup(key string) (interface{}, bool) {
+	if key == "column" && p.spec.Column != "" {
+		return p.spec.Column, true
+	}
+	v, ok := p.spec.Params[key]
+	return v, ok && v != nil
+}
+
+// fail records the first error encountered
+func (p *params) fail(format string, args ...interface{}) {
+	if p.err == nil {
+		p.err = fmt.Errorf(format, args...)
+	}
+}
+
+// str returns a required string param
+func (p *params) str(key string) string {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// strOr returns an optional string param, or def when absent
+func (p *params) strOr(key, def string) string {
+	if _, ok := p.lookup(key); !ok {
+		return def
+	}
+	return p.str(key)
+}
+
+// date returns a required ISO date param. YAML resolves an unquoted date such as 2024-01-01 to a timestamp,
+// which is formatted back to YYYY-MM-DD.
+func (p *params) date(key string) string {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return ""
+	}
+	if t, ok := v.(time.Time); ok {
+		return t.Format("2006-01-02")
+	}
+	return fmt.Sprint(v)
+}
+
+// duration returns a required duration param such as 24h or 90m
+func (p *params) duration(key string) time.Duration {
+	s := p.str(key)
+	if p.err != nil {
+		return 0
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		p.fail("param %q: %q is not a duration", key, s)
+	}
+	return d
+}
+
+// timeOr returns an optional time param, given as RFC 3339 or as a date, or def when absent
+func (p *params) timeOr(key string, def time.Time) time.Time {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	if t, ok := v.(time.Time); ok {
+		return t
+	}
+	s := fmt.Sprint(v)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		p.fail("param %q: %q is not an RFC 3339 time or a date", key, s)
+	}
+	return t
+}
+
+// float returns a required numeric param
+func (p *params) float(key string) float64 {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return 0
+	}
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case float64:
+		return n
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		if err != nil {
+			p.fail("param %q: %q is not a number", key, n)
+		}
+		return f
+	}
+	p.fail("param %q: %v is not a number", key, v)
+	return 0
+}
+
+// floatOr returns an optional numeric param, or def when absent
+func (p *params) floatOr(key string, def float64) float64 {
+	if _, ok := p.lookup(key); !ok {
+		return def
+	}
+	return p.float(key)
+}
+
+// int returns a required integer param
+func (p *params) int(key string) int {
+	f := p.float(key)
+	if f != float64(int(f)) {
+		p.fail("param %q: %v is not an integer", key, f)
+	}
+	return int(f)
+}
+
+// intOr returns an optional integer param, or def when absent
+func (p *params) intOr(key string, def int) int {
+	if _, ok := p.lookup(key); !ok {
+		return def
+	}
+	return p.int(key)
+}
+
+// boolOr returns an optional boolean param, or def when absent
+func (p *params) boolOr(key string, def bool) bool {
+	v, ok := p.lookup(key)
+	if !ok {
+		return def
+	}
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		parsed, err := strconv.ParseBool(b)
+		if err != nil {
+			p.fail("param %q: %q is not a boolean", key, b)
+		}
+		return parsed
+	}
+	p.fail("param %q: %v is not a boolean", key, v)
+	return def
+}
+
+// strs returns a required list param, given either as a YAML list or a comma-separated string
+func (p *params) strs(key string) []string {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return nil
+	}
+	switch list := v.(type) {
+	case []interface{}:
+		out := make([]string, len(list))
+		for i, item := range list {
+			out[i] = fmt.Sprint(item)
+		}
+		return out
+	case string:
+		out := strings.Split(list, ",")
+		for i := range out {
+			out[i] = strings.TrimSpace(out[i])
+		}
+		return out
+	}
+	p.fail("param %q: %v is not a list", key, v)
+	return nil
+}
+
+// strsOr returns an optional list param, or nil when absent
+func (p *params) strsOr(key string) []string {
+	if _, ok := p.lookup(key); !ok {
+		return nil
+	}
+	return p.strs(key)
+}
+
+// mapping returns a required type -> allowed values param, given inline or as a path to a YAML file
+func (p *params) mapping(key string) map[string][]string {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return nil
+	}
+	if path, isPath := v.(string); isPath {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			p.fail("param %q: failed to read mapping file: %v", key, err)
+			return nil
+		}
+		var m map[string][]string
+		if err := yaml.Unmarshal(content, &m); err != nil {
+			p.fail("param %q: failed to parse mapping file: %v", key, err)
+		}
+		return m
+	}
+	raw, isMap := v.(map[string]interface{})
+	if !isMap {
+		p.fail("param %q: expected a mapping or a file path", key)
+		return nil
+	}
+	m := make(map[string][]string, len(raw))
+	for k, values := range raw {
+		list, isList := values.([]interface{})
+		if !isList {
+			p.fail("param %q: values for %q must be a list", key, k)
+			return nil
+		}
+		for _, item := range list {
+			m[k] = append(m[k], fmt.Sprint(item))
+		}
+	}
+	return m
+}
+
+// columnTypes returns a required column -> type param, given as a mapping or a "name:TYPE,..." string
+func (p *params) columnTypes(key string) map[string]string {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return nil
+	}
+	if list, isString := v.(string); isString {
+		types, err := checker.ParseColumnTypes(list)
+		if err != nil {
+			p.fail("param %q: %v", key, err)
+		}
+		return types
+	}
+	raw, isMap := v.(map[string]interface{})
+	if !isMap {
+		p.fail("param %q: expected a mapping or a name:TYPE list", key)
+		return nil
+	}
+	types := make(map[string]string, len(raw))
+	for name, columnType := range raw {
+		types[name] = fmt.Sprint(columnType)
+	}
+	return types
+}
+
+// refSpecs returns a required list of reference files, given inline as {path, key} entries or as a path to a YAML file
+func (p *params) refSpecs(key string) []checker.RefSpec {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return nil
+	}
+	if path, isPath := v.(string); isPath {
+		refs, err := checker.LoadRefSpecs(path)
+		if err != nil {
+			p.fail("param %q: %v", key, err)
+		}
+		return refs
+	}
+	list, isList := v.([]interface{})
+	if !isList {
+		p.fail("param %q: expected a list of references or a file path", key)
+		return nil
+	}
+	refs := make([]checker.RefSpec, len(list))
+	for i, item := range list {
+		entry, isMap := item.(map[string]interface{})
+		if !isMap || entry["path"] == nil {
+			p.fail("param %q: reference %d must have a path", key, i+1)
+			return nil
+		}
+		refs[i].Path = fmt.Sprint(entry["path"])
+		if refKey, hasKey := entry["key"]; hasKey && refKey != nil {
+			refs[i].Key = fmt.Sprint(refKey)
+		}
+	}
+	return refs
+}
+
+// ranges returns a required source -> [min, max] param, given inline or as a path to a YAML file
+func (p *params) ranges(key string) map[string][2]int64 {
+	v, ok := p.lookup(key)
+	if !ok {
+		p.fail("missing required param %q", key)
+		return nil
+	}
+	if path, isPath := v.(string); isPath {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			p.fail("param %q: failed to read ranges file: %v", key, err)
+			return nil
+		}
+		var r map[string][2]int64
+		if err := yaml.Unmarshal(content, &r); err != nil {
+			p.fail("param %q: failed to parse ranges file: %v", key, err)
+		}
+		return r
+	}
+	raw, isMap := v.(map[string]interface{})
+	if !isMap {
+		p.fail("param %q: expected a mapping or a file path", key)
+		return nil
+	}
+	r := make(map[string][2]int64, len(raw))
+	for source, block := range raw {
+		list, isList := block.([]interface{})
+		if !isList || len(list) != 2 {
+			p.fail("param %q: range for %q must be a [min, max] list", key, source)
+			return nil
+		}
+		var bounds [2]int64
+		for i, bound := range list {
+			n, isInt := bound.(int)
+			if !isInt {
+				p.fail("param %q: range bound %v for %q is not an integer", key, bound, source)
+				return nil
+			}
+			bounds[i] = int64(n)
+		}
+		r[source] = bounds
+	}
+	return r
+}
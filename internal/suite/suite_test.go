@@ -0,0 +1,377 @@
+package suite
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/josephmachado/data_quality_checker/internal/checker"
+	"github.com/josephmachado/data_quality_checker/internal/db"
+)
+
+func getTestDataPath(t *testing.T, filename string) string {
+	path, err := filepath.Abs(filepath.Join("..", "..", "tests", "data", filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func setup(t *testing.T) *checker.DataQualityChecker {
+	tempDir := t.TempDir()
+	connector := db.NewDBConnector(filepath.Join(tempDir, "test.db"))
+	c := checker.NewDataQualityChecker(connector)
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestParseSuite(t *testing.T) {
+	t.Run("ValidSuite", func(t *testing.T) {
+		s, err := ParseSuite([]byte(`
+checks:
+  - name: ids unique
+    type: unique
+    data: users.csv
+    column: user_id
+  - type: enum
+    data: users.csv
+    column: name
+    params:
+      enum-values: [Alice, Bob]
+`))
+		if err != nil {
+			t.Fatalf("ParseSuite failed: %v", err)
+		}
+		if len(s.Checks) != 2 {
+			t.Fatalf("Expected 2 checks, got %d", len(s.Checks))
+		}
+		if s.Checks[0].Label() != "ids unique" || s.Checks[1].Label() != "enum" {
+			t.Errorf("Unexpected labels: %q, %q", s.Checks[0].Label(), s.Checks[1].Label())
+		}
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		if _, err := ParseSuite([]byte("checks:\n  - type: nope\n    data: x.csv\n")); err == nil {
+			t.Error("Expected error for unknown check type")
+		}
+	})
+
+	t.Run("MissingData", func(t *testing.T) {
+		if _, err := ParseSuite([]byte("checks:\n  - type: unique\n    column: id\n")); err == nil {
+			t.Error("Expected error for missing data path")
+		}
+	})
+
+	t.Run("DefaultData", func(t *testing.T) {
+		s, err := ParseSuite([]byte("data: users.csv\nchecks:\n  - type: unique\n    column: id\n  - type: unique\n    data: orders.csv\n    column: id\n"))
+		if err != nil {
+			t.Fatalf("ParseSuite failed: %v", err)
+		}
+		if s.Checks[0].Data != "users.csv" || s.Checks[1].Data != "orders.csv" {
+			t.Errorf("Expected the suite data to fill in only missing data paths, got %q and %q", s.Checks[0].Data, s.Checks[1].Data)
+		}
+	})
+
+	t.Run("NegativeWeight", func(t *testing.T) {
+		if _, err := ParseSuite([]byte("checks:\n  - type: unique\n    data: x.csv\n    column: id\n    weight: -1\n")); err == nil {
+			t.Error("Expected error for a negative weight")
+		}
+	})
+
+	t.Run("NoChecks", func(t *testing.T) {
+		if _, err := ParseSuite([]byte("checks: []\n")); err == nil {
+			t.Error("Expected error for empty suite")
+		}
+	})
+}
+
+func TestRunSuite(t *testing.T) {
+	c := setup(t)
+	users := getTestDataPath(t, "users.csv")
+	orders := getTestDataPath(t, "orders.csv")
+	hasNulls := getTestDataPath(t, "has_nulls.csv")
+
+	s := &Suite{Checks: []CheckSpec{
+		{Type: "unique", Data: users, Column: "user_id"},
+		{Type: "not-null", Data: hasNulls, Column: "name"},
+		{Type: "references", Data: orders, Params: map[string]interface{}{"reference": users, "join-keys": []interface{}{"user_id"}}},
+		{Type: "between", Data: users, Column: "user_id"}, // missing min/max
+		{Type: "unique", Data: filepath.Join(t.TempDir(), "missing.csv"), Column: "id"},
+		{Type: "row-count", Data: users, Params: map[string]interface{}{"min": 1, "max": "10"}},
+	}}
+
+	results := RunSuite(c, s)
+	if len(results) != len(s.Checks) {
+		t.Fatalf("Expected %d results, got %d", len(s.Checks), len(results))
+	}
+
+	expected := []struct {
+		passed bool
+		errs   bool
+	}{
+		{true, false},
+		{false, false},
+		{true, false},
+		{false, true},
+		{false, true},
+		{true, false},
+	}
+	for i, want := range expected {
+		got := results[i]
+		if got.Passed != want.passed || (got.Err != nil) != want.errs {
+			t.Errorf("Check %d (%s): got passed=%v err=%v, want passed=%v error=%v", i+1, got.Spec.Label(), got.Passed, got.Err, want.passed, want.errs)
+		}
+	}
+	if AllPassed(results) {
+		t.Error("Expected AllPassed to be false")
+	}
+	if !AllPassed(results[:1]) {
+		t.Error("Expected AllPassed to be true for passing results")
+	}
+}
+
+func TestConditionalEnumMappingFile(t *testing.T) {
+	c := setup(t)
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data.csv")
+	mapping := filepath.Join(dir, "mapping.yaml")
+	if err := os.WriteFile(data, []byte("kind,value\nfruit,apple\nveg,carrot\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(mapping, []byte("fruit: [apple]\nveg: [carrot]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, m := range []interface{}{mapping, map[string]interface{}{"fruit": []interface{}{"apple"}, "veg": []interface{}{"carrot"}}} {
+		passed, err := RunCheck(c, CheckSpec{Type: "conditional-enum", Data: data, Params: map[string]interface{}{
+			"type-column": "kind", "value-column": "value", "mapping": m,
+		}})
+		if err != nil || !passed {
+			t.Errorf("Expected conditional-enum to pass with mapping %v, got %v (err: %v)", m, passed, err)
+		}
+	}
+}
+
+func TestAllocationRangeParam(t *testing.T) {
+	c := setup(t)
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data.csv")
+	rangesFile := filepath.Join(dir, "ranges.yaml")
+	if err := os.WriteFile(data, []byte("id,source\n5,crm\n1200,crm\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(rangesFile, []byte("crm: [1, 999]\nweb: [1000, 1999]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	inline := map[string]interface{}{"crm": []interface{}{1, 999}, "web": []interface{}{1000, 1999}}
+	for _, r := range []interface{}{rangesFile, inline} {
+		passed, err := RunCheck(c, CheckSpec{Type: "allocation-range", Data: data, Params: map[string]interface{}{
+			"id-column": "id", "source-column": "source", "ranges": r,
+		}})
+		if err != nil {
+			t.Fatalf("Unexpected error with ranges %v: %v", r, err)
+		}
+		if passed {
+			t.Errorf("Expected ID 1200 attributed to crm to fail with ranges %v", r)
+		}
+	}
+}
+
+func TestCheckFilter(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(data, []byte("region,email\nEU,a@example.com\nUS,\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	passed, err := RunCheck(c, CheckSpec{Type: "not-null", Data: data, Column: "email", Filter: "region = 'EU'"})
+	if err != nil || !passed {
+		t.Errorf("Expected filtered not-null to pass, got %v (err: %v)", passed, err)
+	}
+	if c.ScanOptions().Filter != "" {
+		t.Errorf("Expected the spec filter to be cleared after the check, got %q", c.ScanOptions().Filter)
+	}
+	passed, err = RunCheck(c, CheckSpec{Type: "not-null", Data: data, Column: "email"})
+	if err != nil || passed {
+		t.Errorf("Expected unfiltered not-null to fail, got %v (err: %v)", passed, err)
+	}
+}
+
+func TestSchemaTypesParam(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(data, []byte("id,price\n1,9.99\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, types := range []interface{}{"id:BIGINT,price:DOUBLE", map[string]interface{}{"id": "BIGINT", "price": "DOUBLE"}} {
+		passed, err := RunCheck(c, CheckSpec{Type: "schema-types", Data: data, Params: map[string]interface{}{"types": types}})
+		if err != nil || !passed {
+			t.Errorf("Expected schema-types to pass with types %v, got %v (err: %v)", types, passed, err)
+		}
+	}
+}
+
+func TestRefSpecsParam(t *testing.T) {
+	c := setup(t)
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data.csv")
+	eu := filepath.Join(dir, "eu.csv")
+	us := filepath.Join(dir, "us.csv")
+	refsFile := filepath.Join(dir, "refs.yaml")
+	for path, content := range map[string]string{
+		data:     "customer_id\n10\n20\n",
+		eu:       "customer_id\n10\n",
+		us:       "id\n20\n",
+		refsFile: "- path: " + eu + "\n- path: " + us + "\n  key: id\n",
+	} {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	inline := []interface{}{
+		map[string]interface{}{"path": eu},
+		map[string]interface{}{"path": us, "key": "id"},
+	}
+	for _, refs := range []interface{}{refsFile, inline} {
+		passed, err := RunCheck(c, CheckSpec{Type: "in-any-reference", Data: data, Column: "customer_id", Params: map[string]interface{}{"references": refs}})
+		if err != nil || !passed {
+			t.Errorf("Expected in-any-reference to pass with references %v, got %v (err: %v)", refs, passed, err)
+		}
+	}
+}
+
+func TestScoreChecks(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(data, []byte("id,email\n1,a@example.com\n1,\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The failing uniqueness check costs little at weight 1 and sinks the score at weight 9
+	for _, tc := range []struct {
+		weight float64
+		want   bool
+	}{{1, true}, {9, false}} {
+		s := &Suite{Data: data, Checks: []CheckSpec{
+			{Type: "unique", Data: data, Column: "id", Weight: tc.weight},
+			{Type: "not-null", Data: data, Column: "id", Weight: 4},
+		}}
+		passed, err := c.IsScoreAtLeast(s.Data, ScoreChecks(c, s), 70)
+		if err != nil {
+			t.Fatalf("IsScoreAtLeast failed: %v", err)
+		}
+		if passed != tc.want {
+			t.Errorf("With the unique check weighted %v, expected pass=%v, got score %v", tc.weight, tc.want, c.LastResultParams()["score"])
+		}
+	}
+
+	checks := ScoreChecks(c, &Suite{Checks: []CheckSpec{{Type: "unique", Data: data, Column: "id"}}})
+	if checks[0].Weight != 1 || checks[0].Name != "unique" {
+		t.Errorf("Expected an unweighted check to default to weight 1, got %+v", checks[0])
+	}
+}
+
+func TestSeverity(t *testing.T) {
+	c := setup(t)
+	duplicates := getTestDataPath(t, "duplicate_data.csv")
+
+	if _, err := ParseSuite([]byte("checks:\n  - type: unique\n    data: x.csv\n    column: id\n    severity: fatal\n")); err == nil {
+		t.Error("Expected error for an unknown severity")
+	}
+
+	results := RunSuite(c, &Suite{Checks: []CheckSpec{
+		{Type: "unique", Data: duplicates, Column: "id", Severity: "warn"},
+		{Type: "unique", Data: duplicates, Column: "id"},
+	}})
+	if results[0].Severity != checker.SeverityWarn || results[0].Blocking() {
+		t.Errorf("Expected the warn failure not to block, got %+v", results[0])
+	}
+	if results[1].Severity != checker.SeverityError || !results[1].Blocking() {
+		t.Errorf("Expected the default severity failure to block, got %+v", results[1])
+	}
+	if c.Severity() != checker.SeverityError {
+		t.Errorf("Expected the spec severity to be reset after the check, got %q", c.Severity())
+	}
+}
+
+func TestDateParam(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(data, []byte("signup\n2024-01-15\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Unquoted YAML dates arrive as timestamps, quoted ones as strings
+	s, err := ParseSuite([]byte("checks:\n  - type: date-between\n    data: " + data + "\n    column: signup\n    params:\n      min: 2024-01-01\n      max: '2024-12-31'\n"))
+	if err != nil {
+		t.Fatalf("ParseSuite failed: %v", err)
+	}
+	passed, err := RunCheck(c, s.Checks[0])
+	if err != nil || !passed {
+		t.Errorf("Expected date-between to pass, got %v (err: %v)", passed, err)
+	}
+}
+
+func TestFreshnessParams(t *testing.T) {
+	c := setup(t)
+	data := filepath.Join(t.TempDir(), "data.csv")
+	if err := os.WriteFile(data, []byte("loaded_at\n2024-06-01 10:30:00\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		maxAge string
+		want   bool
+	}{{"2h", true}, {"1h", false}} {
+		passed, err := RunCheck(c, CheckSpec{Type: "freshness", Data: data, Column: "loaded_at", Params: map[string]interface{}{
+			"max-age": tc.maxAge, "as-of": "2024-06-01T12:00:00Z",
+		}})
+		if err != nil || passed != tc.want {
+			t.Errorf("With max-age %s, expected pass=%v, got %v (err: %v)", tc.maxAge, tc.want, passed, err)
+		}
+	}
+
+	if _, err := RunCheck(c, CheckSpec{Type: "freshness", Data: data, Column: "loaded_at", Params: map[string]interface{}{"max-age": "a day"}}); err == nil {
+		t.Error("Expected error for an invalid duration")
+	}
+}
+
+func TestLoadSuiteDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"users.yaml":  "checks:\n  - type: unique\n    data: users.csv\n    column: user_id\n",
+		"orders.yml":  "checks:\n  - type: not-null\n    data: orders.csv\n    column: order_id\n",
+		"broken.yaml": "checks:\n  - type: nope\n    data: x.csv\n",
+		"notes.txt":   "not a suite",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := LoadSuiteDir(dir)
+	if err != nil {
+		t.Fatalf("LoadSuiteDir failed: %v", err)
+	}
+	if len(files) != 3 {
+		t.Fatalf("Expected 3 suite files, got %d", len(files))
+	}
+	for i, want := range []string{"broken.yaml", "orders.yml", "users.yaml"} {
+		if filepath.Base(files[i].Path) != want {
+			t.Errorf("Expected file %d to be %s, got %s", i, want, files[i].Path)
+		}
+	}
+	if files[0].Err == nil || files[0].Suite != nil {
+		t.Errorf("Expected the broken file to carry its parse error, got %+v", files[0])
+	}
+	if files[1].Err != nil || files[2].Err != nil {
+		t.Errorf("Expected the valid files to load, got %v and %v", files[1].Err, files[2].Err)
+	}
+
+	if _, err := LoadSuiteDir(t.TempDir()); err == nil {
+		t.Error("Expected error for a directory without suite files")
+	}
+}